@@ -0,0 +1,206 @@
+// Package bot is a Twitch chat bot that buttifies messages in the channels it joins.
+package bot
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/douglascdev/buttifier"
+)
+
+var (
+	// Twitch asked the bot to reconnect, Run can be called again
+	ErrReconnect = errors.New("bot: server requested a reconnect")
+	// the server rejected the token
+	ErrAuthentication = errors.New("bot: authentication failed")
+)
+
+type Config struct {
+	// where to connect, see Dial. defaults to TwitchAddr
+	Addr string
+	// the bot account's login name
+	Nick string
+	// OAuth token for Nick, with or without the "oauth:" prefix
+	Token string
+	// channels to join, with or without the leading "#"
+	Channels []string
+}
+
+type Bot struct {
+	Config
+	Buttifier *buttifier.Buttifier
+	// per-channel and per-user settings on top of Buttifier's, can be nil
+	Profiles *buttifier.Profiles
+	// opens the connection, defaults to Dial
+	Dial func(ctx context.Context, addr string) (io.ReadWriteCloser, error)
+	// called with every reply the bot sends, can be nil
+	OnReply func(channel string, original string, result buttifier.Result)
+}
+
+func New(config Config, b *buttifier.Buttifier) *Bot {
+	return &Bot{Config: config, Buttifier: b, Dial: Dial}
+}
+
+// connects, joins the channels and replies to chat messages until ctx is done,
+// the connection drops or the server asks for a reconnect
+func (bot *Bot) Run(ctx context.Context) error {
+	addr := bot.Addr
+	if addr == "" {
+		addr = TwitchAddr
+	}
+	dial := bot.Dial
+	if dial == nil {
+		dial = Dial
+	}
+
+	conn, err := dial(ctx, addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// unblock the read loop when ctx is canceled
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	if err := bot.login(conn); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		message, err := ParseMessage(scanner.Text())
+		if err != nil {
+			continue
+		}
+		if err := bot.handle(conn, message); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return io.EOF
+}
+
+func (bot *Bot) login(conn io.Writer) error {
+	token := bot.Token
+	if !strings.HasPrefix(token, "oauth:") {
+		token = "oauth:" + token
+	}
+
+	messages := []Message{
+		{Command: "CAP", Params: []string{"REQ", "twitch.tv/tags twitch.tv/commands"}},
+		{Command: "PASS", Params: []string{token}},
+		{Command: "NICK", Params: []string{strings.ToLower(bot.Nick)}},
+	}
+	for _, channel := range bot.Channels {
+		messages = append(messages, Message{Command: "JOIN", Params: []string{normalizeChannel(channel)}})
+	}
+
+	for _, message := range messages {
+		if err := send(conn, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bot *Bot) handle(conn io.Writer, message Message) error {
+	switch message.Command {
+	case "PING":
+		return send(conn, Message{Command: "PONG", Params: message.Params})
+	case "RECONNECT":
+		return ErrReconnect
+	case "NOTICE":
+		if len(message.Params) == 2 && strings.Contains(message.Params[1], "authentication failed") {
+			return fmt.Errorf("%w: %s", ErrAuthentication, message.Params[1])
+		}
+	case "PRIVMSG":
+		if len(message.Params) != 2 || strings.EqualFold(message.Nick(), bot.Nick) {
+			return nil
+		}
+		return bot.reply(conn, message)
+	}
+	return nil
+}
+
+// buttifies a chat message according to ButtificationProbability and answers it
+func (bot *Bot) reply(conn io.Writer, message Message) error {
+	channel, text := message.Params[0], message.Params[1]
+	id := message.Tags["id"]
+
+	// "/me waves" arrives as "\x01ACTION waves\x01", only the text in between is buttified
+	text, action := strings.CutPrefix(text, actionPrefix)
+	if action {
+		text = strings.TrimSuffix(text, actionSuffix)
+	}
+	// other bots would run a buttified "!command" as if we sent it
+	if isCommand(text) {
+		return nil
+	}
+
+	var result buttifier.Result
+	if bot.Profiles != nil {
+		result = bot.Profiles.MaybeButtify(channel, message.Nick(), id, text)
+	} else {
+		result = bot.Buttifier.MaybeButtifyMessage(id, text)
+	}
+	if !result.Changed {
+		return nil
+	}
+
+	output := result.Output
+	if action {
+		output = actionPrefix + output + actionSuffix
+	}
+	reply := Message{Command: "PRIVMSG", Params: []string{channel, output}}
+	if id != "" {
+		reply.Tags = map[string]string{"reply-parent-msg-id": id}
+	}
+	if err := send(conn, reply); err != nil {
+		return err
+	}
+	if bot.OnReply != nil {
+		bot.OnReply(channel, message.Params[1], result)
+	}
+	return nil
+}
+
+// the CTCP framing of a /me message
+const actionPrefix, actionSuffix = "\x01ACTION ", "\x01"
+
+// whether the first token of text is a chat command like "!uptime"
+func isCommand(text string) bool {
+	for _, token := range buttifier.Tokenize(text) {
+		if token.Kind != buttifier.TokenSpace {
+			return token.Kind == buttifier.TokenCommand
+		}
+	}
+	return false
+}
+
+func send(conn io.Writer, message Message) error {
+	_, err := io.WriteString(conn, message.String()+"\r\n")
+	return err
+}
+
+// "SomeChannel" -> "#somechannel"
+func normalizeChannel(channel string) string {
+	return "#" + strings.ToLower(strings.TrimPrefix(channel, "#"))
+}
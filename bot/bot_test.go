@@ -0,0 +1,216 @@
+package bot
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/douglascdev/buttifier"
+)
+
+// a fake IRC server that records what the bot sends, and sends lines to the bot
+type fakeServer struct {
+	listener net.Listener
+	conn     chan net.Conn
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	server := &fakeServer{listener: listener, conn: make(chan net.Conn, 1)}
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			server.conn <- conn
+		}
+	}()
+	return server
+}
+
+func newTestButtifier(t *testing.T) *buttifier.Buttifier {
+	b, err := buttifier.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationProbability = 1
+	b.ButtificationRate = 1
+	return b
+}
+
+// reads lines from reader until one starts with prefix
+func expectLine(t *testing.T, reader *bufio.Reader, prefix string) string {
+	t.Helper()
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("expected a line starting with %q, got %v", prefix, err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if strings.HasPrefix(line, prefix) {
+			return line
+		}
+	}
+}
+
+func runBot(t *testing.T, bot *Bot) (context.CancelFunc, chan error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	errs := make(chan error, 1)
+	go func() { errs <- bot.Run(ctx) }()
+	t.Cleanup(cancel)
+	return cancel, errs
+}
+
+func TestBotRepliesOverTCP(t *testing.T) {
+	server := newFakeServer(t)
+	bot := New(Config{
+		Addr:     server.listener.Addr().String(),
+		Nick:     "ButtBot",
+		Token:    "secret",
+		Channels: []string{"SomeChannel"},
+	}, newTestButtifier(t))
+	cancel, errs := runBot(t, bot)
+
+	conn := <-server.conn
+	reader := bufio.NewReader(conn)
+	expectLine(t, reader, "CAP REQ :twitch.tv/tags twitch.tv/commands")
+	expectLine(t, reader, "PASS oauth:secret")
+	expectLine(t, reader, "NICK buttbot")
+	expectLine(t, reader, "JOIN #somechannel")
+
+	conn.Write([]byte("PING :tmi.twitch.tv\r\n"))
+	expectLine(t, reader, "PONG tmi.twitch.tv")
+
+	// the bot's own messages are ignored
+	conn.Write([]byte(":buttbot!buttbot@buttbot.tmi.twitch.tv PRIVMSG #somechannel :grinding for partner\r\n"))
+	conn.Write([]byte("@id=msg-1 :someone!someone@someone.tmi.twitch.tv PRIVMSG #somechannel :grinding for partner\r\n"))
+	reply := expectLine(t, reader, "@reply-parent-msg-id=msg-1 PRIVMSG #somechannel")
+	if reply != "@reply-parent-msg-id=msg-1 PRIVMSG #somechannel :buttbutt butt buttbutt" {
+		t.Errorf("unexpected reply %q", reply)
+	}
+
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestBotUsesProfiles(t *testing.T) {
+	server := newFakeServer(t)
+	b := newTestButtifier(t)
+	bot := New(Config{Addr: server.listener.Addr().String(), Nick: "buttbot"}, b)
+	bot.Profiles = buttifier.NewProfiles(b)
+	bot.Profiles.SetChannel("booty", buttifier.Profile{ButtWord: buttifier.Override("booty")})
+	runBot(t, bot)
+
+	conn := <-server.conn
+	reader := bufio.NewReader(conn)
+	conn.Write([]byte(":someone!someone@someone.tmi.twitch.tv PRIVMSG #booty :grinding\r\n"))
+	if reply := expectLine(t, reader, "PRIVMSG"); reply != "PRIVMSG #booty :bootybooty" {
+		t.Errorf("unexpected reply %q", reply)
+	}
+	conn.Write([]byte(":someone!someone@someone.tmi.twitch.tv PRIVMSG #other :grinding\r\n"))
+	if reply := expectLine(t, reader, "PRIVMSG"); reply != "PRIVMSG #other :buttbutt" {
+		t.Errorf("unexpected reply %q", reply)
+	}
+}
+
+func TestBotActionsAndCommands(t *testing.T) {
+	server := newFakeServer(t)
+	bot := New(Config{Addr: server.listener.Addr().String(), Nick: "buttbot"}, newTestButtifier(t))
+	runBot(t, bot)
+
+	conn := <-server.conn
+	reader := bufio.NewReader(conn)
+	// commands are never answered, so the next reply is the one to the /me message
+	conn.Write([]byte(":someone!someone@someone.tmi.twitch.tv PRIVMSG #somechannel :!somecmd grinding\r\n"))
+	conn.Write([]byte(":someone!someone@someone.tmi.twitch.tv PRIVMSG #somechannel :\x01ACTION grinding for partner\x01\r\n"))
+	if reply := expectLine(t, reader, "PRIVMSG"); reply != "PRIVMSG #somechannel :\x01ACTION buttbutt butt buttbutt\x01" {
+		t.Errorf("unexpected reply %q", reply)
+	}
+	conn.Write([]byte(":someone!someone@someone.tmi.twitch.tv PRIVMSG #somechannel :\x01ACTION !somecmd grinding\x01\r\n"))
+	conn.Write([]byte(":someone!someone@someone.tmi.twitch.tv PRIVMSG #somechannel :grinding\r\n"))
+	if reply := expectLine(t, reader, "PRIVMSG"); reply != "PRIVMSG #somechannel :buttbutt" {
+		t.Errorf("unexpected reply %q", reply)
+	}
+}
+
+func TestBotReconnect(t *testing.T) {
+	server := newFakeServer(t)
+	bot := New(Config{Addr: server.listener.Addr().String(), Nick: "buttbot"}, newTestButtifier(t))
+	_, errs := runBot(t, bot)
+
+	conn := <-server.conn
+	conn.Write([]byte(":tmi.twitch.tv RECONNECT\r\n"))
+	if err := <-errs; !errors.Is(err, ErrReconnect) {
+		t.Errorf("expected ErrReconnect, got %v", err)
+	}
+}
+
+func TestBotRepliesOverWebSocket(t *testing.T) {
+	lines := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "websocket" {
+			http.Error(w, "expected a websocket upgrade", http.StatusBadRequest)
+			return
+		}
+		conn, buffered, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+
+		buffered.WriteString("HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n")
+		buffered.WriteString("Sec-WebSocket-Accept: " + webSocketAccept(r.Header.Get("Sec-WebSocket-Key")) + "\r\n\r\n")
+		buffered.Flush()
+
+		sendText := func(text string) {
+			frame := appendFrameHeader(nil, opText, len(text), nil)
+			conn.Write(append(frame, text...))
+		}
+		sendText("@id=msg-2 :someone!someone@someone.tmi.twitch.tv PRIVMSG #channel :grinding\r\n")
+
+		for {
+			opcode, payload, err := readFrame(buffered.Reader)
+			if err != nil {
+				return
+			}
+			if opcode == opText {
+				lines <- strings.TrimRight(string(payload), "\r\n")
+			}
+		}
+	}))
+	defer server.Close()
+
+	bot := New(Config{
+		Addr:     "ws://" + strings.TrimPrefix(server.URL, "http://"),
+		Nick:     "buttbot",
+		Channels: []string{"channel"},
+	}, newTestButtifier(t))
+	runBot(t, bot)
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case line := <-lines:
+			if strings.Contains(line, "PRIVMSG") {
+				if line != "@reply-parent-msg-id=msg-2 PRIVMSG #channel :buttbutt" {
+					t.Errorf("unexpected reply %q", line)
+				}
+				return
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for the reply")
+		}
+	}
+}
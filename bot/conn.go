@@ -0,0 +1,274 @@
+package bot
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	// Twitch IRC over TLS
+	TwitchAddr = "ircs://irc.chat.twitch.tv:6697"
+	// Twitch IRC over a secure WebSocket
+	TwitchWebSocketAddr = "wss://irc-ws.chat.twitch.tv:443"
+)
+
+var ErrWebSocketHandshake = errors.New("bot: websocket handshake failed")
+
+// connects to addr, which is one of
+//
+//	irc://host:port   plain TCP
+//	ircs://host:port  TCP over TLS
+//	ws://host:port    WebSocket
+//	wss://host:port   WebSocket over TLS
+//	host:port         plain TCP, handy for a local fake server
+func Dial(ctx context.Context, addr string) (io.ReadWriteCloser, error) {
+	if !strings.Contains(addr, "://") {
+		return dialTCP(ctx, addr, false)
+	}
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, fmt.Errorf("bot: invalid address %q: %w", addr, err)
+	}
+	switch u.Scheme {
+	case "irc":
+		return dialTCP(ctx, u.Host, false)
+	case "ircs":
+		return dialTCP(ctx, u.Host, true)
+	case "ws":
+		return dialWebSocket(ctx, u, false)
+	case "wss":
+		return dialWebSocket(ctx, u, true)
+	default:
+		return nil, fmt.Errorf("bot: unsupported scheme %q in %q", u.Scheme, addr)
+	}
+}
+
+func dialTCP(ctx context.Context, hostport string, useTLS bool) (net.Conn, error) {
+	if useTLS {
+		dialer := &tls.Dialer{}
+		return dialer.DialContext(ctx, "tcp", hostport)
+	}
+	dialer := &net.Dialer{}
+	return dialer.DialContext(ctx, "tcp", hostport)
+}
+
+// a minimal WebSocket client, just enough for Twitch IRC: every Write is sent as
+// one text frame, and Read returns the payload of the text frames it receives
+type webSocketConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	// payload left over from the last frame
+	pending []byte
+
+	writeMu sync.Mutex
+}
+
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+// from RFC 6455
+const webSocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// IRC lines are tiny, anything bigger than this is a broken or hostile server
+const maxFramePayload = 1 << 20
+
+var ErrFrameTooLarge = errors.New("bot: websocket frame too large")
+
+func dialWebSocket(ctx context.Context, u *url.URL, useTLS bool) (*webSocketConn, error) {
+	host := u.Host
+	if u.Port() == "" {
+		if useTLS {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		} else {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+	conn, err := dialTCP(ctx, host, useTLS)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, 16)
+	rand.Read(nonce)
+	key := base64.StdEncoding.EncodeToString(nonce)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	request.Header.Set("Upgrade", "websocket")
+	request.Header.Set("Connection", "Upgrade")
+	request.Header.Set("Sec-WebSocket-Key", key)
+	request.Header.Set("Sec-WebSocket-Version", "13")
+	request.Header.Set("Sec-WebSocket-Protocol", "irc")
+	if err := request.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, request)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		conn.Close()
+		return nil, fmt.Errorf("%w: %s", ErrWebSocketHandshake, response.Status)
+	}
+	if response.Header.Get("Sec-WebSocket-Accept") != webSocketAccept(key) {
+		conn.Close()
+		return nil, fmt.Errorf("%w: wrong Sec-WebSocket-Accept", ErrWebSocketHandshake)
+	}
+
+	return &webSocketConn{conn: conn, reader: reader}, nil
+}
+
+// the Sec-WebSocket-Accept a server must answer to key
+func webSocketAccept(key string) string {
+	hash := sha1.Sum([]byte(key + webSocketGUID))
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+func (c *webSocketConn) Read(p []byte) (int, error) {
+	for len(c.pending) == 0 {
+		opcode, payload, err := readFrame(c.reader)
+		if err != nil {
+			return 0, err
+		}
+		switch opcode {
+		case opText, opBinary, opContinuation:
+			c.pending = payload
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return 0, err
+			}
+		case opClose:
+			c.writeFrame(opClose, nil)
+			return 0, io.EOF
+		}
+	}
+
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *webSocketConn) Write(p []byte) (int, error) {
+	if err := c.writeFrame(opText, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (c *webSocketConn) Close() error {
+	return c.conn.Close()
+}
+
+// clients must mask every frame they send
+func (c *webSocketConn) writeFrame(opcode byte, payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	mask := make([]byte, 4)
+	rand.Read(mask)
+	frame := appendFrameHeader(nil, opcode, len(payload), mask)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	_, err := c.conn.Write(frame)
+	return err
+}
+
+// appends a final frame header, with the masking key when mask isn't nil
+func appendFrameHeader(frame []byte, opcode byte, length int, mask []byte) []byte {
+	frame = append(frame, 0x80|opcode)
+
+	var maskBit byte
+	if mask != nil {
+		maskBit = 0x80
+	}
+	switch {
+	case length < 126:
+		frame = append(frame, maskBit|byte(length))
+	case length <= 0xffff:
+		frame = append(frame, maskBit|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(length))
+	default:
+		frame = append(frame, maskBit|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(length))
+	}
+
+	return append(frame, mask...)
+}
+
+// reads a single frame, unmasking its payload if needed
+func readFrame(reader *bufio.Reader) (byte, []byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return 0, nil, err
+	}
+	opcode := header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		extended := make([]byte, 2)
+		if _, err := io.ReadFull(reader, extended); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended))
+	case 127:
+		extended := make([]byte, 8)
+		if _, err := io.ReadFull(reader, extended); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended)
+	}
+
+	if length > maxFramePayload {
+		return 0, nil, ErrFrameTooLarge
+	}
+
+	var mask []byte
+	if masked {
+		mask = make([]byte, 4)
+		if _, err := io.ReadFull(reader, mask); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return 0, nil, err
+	}
+	for i := range payload {
+		if masked {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return opcode, payload, nil
+}
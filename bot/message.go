@@ -0,0 +1,148 @@
+package bot
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+var ErrEmptyMessage = errors.New("bot: empty IRC message")
+
+// a single IRC line, with the IRCv3 tags Twitch sends
+//
+//	@id=123;display-name=someone :someone!someone@someone.tmi.twitch.tv PRIVMSG #channel :hello there
+type Message struct {
+	Tags    map[string]string
+	Prefix  string
+	Command string
+	Params  []string
+}
+
+// parses a line without the trailing "\r\n"
+func ParseMessage(line string) (Message, error) {
+	line = strings.TrimRight(line, "\r\n")
+	var message Message
+
+	if strings.HasPrefix(line, "@") {
+		var tags string
+		tags, line, _ = strings.Cut(line[1:], " ")
+		message.Tags = map[string]string{}
+		for _, tag := range strings.Split(tags, ";") {
+			key, value, _ := strings.Cut(tag, "=")
+			message.Tags[key] = unescapeTagValue(value)
+		}
+		line = strings.TrimLeft(line, " ")
+	}
+
+	if strings.HasPrefix(line, ":") {
+		message.Prefix, line, _ = strings.Cut(line[1:], " ")
+		line = strings.TrimLeft(line, " ")
+	}
+
+	message.Command, line, _ = strings.Cut(line, " ")
+	if message.Command == "" {
+		return Message{}, ErrEmptyMessage
+	}
+
+	for line != "" {
+		line = strings.TrimLeft(line, " ")
+		if strings.HasPrefix(line, ":") {
+			// the trailing parameter can contain spaces
+			message.Params = append(message.Params, line[1:])
+			break
+		}
+		var param string
+		param, line, _ = strings.Cut(line, " ")
+		if param != "" {
+			message.Params = append(message.Params, param)
+		}
+	}
+
+	return message, nil
+}
+
+// formats the message as a line without the trailing "\r\n"
+func (m Message) String() string {
+	var builder strings.Builder
+
+	if len(m.Tags) > 0 {
+		keys := make([]string, 0, len(m.Tags))
+		for key := range m.Tags {
+			keys = append(keys, key)
+		}
+		// sorted so the output is stable
+		slices.Sort(keys)
+
+		builder.WriteByte('@')
+		for i, key := range keys {
+			if i > 0 {
+				builder.WriteByte(';')
+			}
+			builder.WriteString(key)
+			if value := m.Tags[key]; value != "" {
+				builder.WriteByte('=')
+				builder.WriteString(escapeTagValue(value))
+			}
+		}
+		builder.WriteByte(' ')
+	}
+
+	if m.Prefix != "" {
+		builder.WriteByte(':')
+		builder.WriteString(m.Prefix)
+		builder.WriteByte(' ')
+	}
+
+	builder.WriteString(m.Command)
+	for i, param := range m.Params {
+		builder.WriteByte(' ')
+		// the last of several params is the text, like the message of a PRIVMSG
+		last := i == len(m.Params)-1
+		if last && (i > 0 || param == "" || strings.Contains(param, " ") || strings.HasPrefix(param, ":")) {
+			builder.WriteByte(':')
+		}
+		builder.WriteString(param)
+	}
+
+	return builder.String()
+}
+
+// the nickname in the prefix, "someone" for "someone!someone@someone.tmi.twitch.tv"
+func (m Message) Nick() string {
+	nick, _, _ := strings.Cut(m.Prefix, "!")
+	return nick
+}
+
+var tagEscaper = strings.NewReplacer(`\`, `\\`, ";", `\:`, " ", `\s`, "\r", `\r`, "\n", `\n`)
+
+func escapeTagValue(value string) string {
+	return tagEscaper.Replace(value)
+}
+
+func unescapeTagValue(value string) string {
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' {
+			builder.WriteByte(value[i])
+			continue
+		}
+		i++
+		if i == len(value) {
+			// a lone trailing backslash is dropped
+			break
+		}
+		switch value[i] {
+		case ':':
+			builder.WriteByte(';')
+		case 's':
+			builder.WriteByte(' ')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'n':
+			builder.WriteByte('\n')
+		default:
+			builder.WriteByte(value[i])
+		}
+	}
+	return builder.String()
+}
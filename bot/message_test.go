@@ -0,0 +1,69 @@
+package bot
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseMessage(t *testing.T) {
+	line := `@badge-info=;display-name=Some\sOne;id=abc-123;emotes= :someone!someone@someone.tmi.twitch.tv PRIVMSG #channel :hello there :)` + "\r\n"
+	message, err := ParseMessage(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.Tags["display-name"] != "Some One" || message.Tags["id"] != "abc-123" {
+		t.Errorf("unexpected tags %v", message.Tags)
+	}
+	if _, ok := message.Tags["emotes"]; !ok {
+		t.Error("expected empty tags to be kept")
+	}
+	if message.Prefix != "someone!someone@someone.tmi.twitch.tv" || message.Nick() != "someone" {
+		t.Errorf("unexpected prefix %q", message.Prefix)
+	}
+	if message.Command != "PRIVMSG" {
+		t.Errorf("expected PRIVMSG, got %s", message.Command)
+	}
+	if !slices.Equal(message.Params, []string{"#channel", "hello there :)"}) {
+		t.Errorf("unexpected params %q", message.Params)
+	}
+}
+
+func TestParseMessageWithoutTagsOrPrefix(t *testing.T) {
+	message, err := ParseMessage("PING :tmi.twitch.tv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if message.Command != "PING" || !slices.Equal(message.Params, []string{"tmi.twitch.tv"}) {
+		t.Errorf("unexpected message %+v", message)
+	}
+
+	if _, err := ParseMessage(""); err != ErrEmptyMessage {
+		t.Errorf("expected ErrEmptyMessage, got %v", err)
+	}
+}
+
+func TestMessageString(t *testing.T) {
+	resultMap := map[string]Message{
+		"PONG tmi.twitch.tv":       {Command: "PONG", Params: []string{"tmi.twitch.tv"}},
+		"JOIN #channel":            {Command: "JOIN", Params: []string{"#channel"}},
+		"PRIVMSG #channel :hi all": {Command: "PRIVMSG", Params: []string{"#channel", "hi all"}},
+		`@a=x\sy\:z;b=1 PRIVMSG #channel :x`: {
+			Tags:    map[string]string{"b": "1", "a": "x y;z"},
+			Command: "PRIVMSG",
+			Params:  []string{"#channel", "x"},
+		},
+	}
+	for expected, message := range resultMap {
+		if actual := message.String(); actual != expected {
+			t.Errorf("expected %q, got %q", expected, actual)
+		}
+		parsed, err := ParseMessage(expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed.String() != expected {
+			t.Errorf("expected %q to survive a round trip, got %q", expected, parsed.String())
+		}
+	}
+}
@@ -2,11 +2,10 @@ package buttifier
 
 import (
 	"math/rand/v2"
-	"slices"
 	"strings"
+	"sync"
 	"unicode"
-
-	"github.com/speedata/hyphenation"
+	"unicode/utf8"
 )
 
 /*
@@ -19,57 +18,124 @@ func (DefaultRandSource) Uint64() uint64 {
 	return rand.Uint64()
 }
 
+// Buttifier is safe for concurrent use once it's configured:
+//   - set Config fields and RandSource directly only before sharing the Buttifier between goroutines
+//   - after that, change settings with Update, SetLanguage and AddLanguage, which can run
+//     alongside any other call
+//   - every call works on a snapshot of Config taken when it starts, so it never sees
+//     a half-applied Update
+//   - RandSource doesn't have to be safe for concurrent use, calls to it are serialized
 type Buttifier struct {
-	hyphenator               *hyphenation.Lang
-	ButtWord                 string
-	ButtificationProbability float64
-	ButtificationRate        float64
-	RandSource               rand.Source
+	Config
+	RandSource rand.Source
+
+	// guards Config, language and syllabifiers
+	mu sync.RWMutex
+	// serializes calls to RandSource
+	randMu sync.Mutex
+	// syllabifiers of the languages loaded into this buttifier, keyed by language code.
+	// replaced instead of modified, so snapshots can keep reading the old map
+	syllabifiers map[string]Syllabifier
+	language     string
+	// set with WithSyllabifier, used for every language instead of TeX patterns
+	syllabifier Syllabifier
+	// set with WithCache, nil when disabled
+	cache *hyphenationCache
 }
 
-type syllable struct {
+// IdxStart and IdxEnd are rune offsets into the hyphenated word
+type Syllable struct {
 	Letters  string
 	IdxStart int
 	IdxEnd   int
 }
 
-type hyphenatedWord struct {
+type HyphenatedWord struct {
 	Word        string
 	Breakpoints []int
-	Syllables   []*syllable
+	Syllables   []*Syllable
+	// language whose patterns were used, "" if the word should be left alone
+	Language string
+	// number of trailing syllables that are a suffix kept by Config.KeepSuffixes
+	keptSuffix int
+	// matched by Config.Exclusions
+	excluded bool
+	// an emote code, replaced as a whole with Config.ButtEmote
+	emote bool
+	// stress of each syllable according to the pronunciation dictionary,
+	// nil for words missing from it
+	Stress []Stress
+}
+
+// whether syllable i of w may be replaced. selection strategies must only pick these
+func (w *HyphenatedWord) Replaceable(i int) bool {
+	return (w.Language != "" || w.emote) && !w.excluded && i < len(w.Syllables)-w.keptSuffix
 }
 
-func New() (*Buttifier, error) {
-	hyph, err := hyphenation.New(strings.NewReader(HyphenatorData))
-	if err != nil {
+// creates a Buttifier for DefaultLanguage, unless options say otherwise
+func New(options ...Option) (*Buttifier, error) {
+	o := newOptions{language: DefaultLanguage}
+	for _, option := range options {
+		option(&o)
+	}
+
+	b := &Buttifier{
+		Config: Config{
+			ButtWord:                 "butt",
+			ButtificationProbability: 0.05,
+			ButtificationRate:        0.3,
+			Rounding:                 RoundCeil,
+			DetectionThreshold:       0.6,
+		},
+		RandSource:   DefaultRandSource{},
+		syllabifiers: map[string]Syllabifier{},
+		syllabifier:  o.syllabifier,
+	}
+	if o.randSource != nil {
+		b.RandSource = o.randSource
+	}
+	if o.cacheSize > 0 {
+		b.cache = newHyphenationCache(o.cacheSize)
+	}
+	for _, code := range o.languages {
+		if err := b.AddLanguage(code); err != nil {
+			return nil, err
+		}
+	}
+	if err := b.SetLanguage(o.language); err != nil {
 		return nil, err
 	}
-	return &Buttifier{
-		ButtWord:                 "butt",
-		hyphenator:               hyph,
-		ButtificationProbability: 0.05,
-		ButtificationRate:        0.3,
-		RandSource:               DefaultRandSource{},
-	}, nil
+	return b, nil
+}
+
+// same as New(WithLanguage(code))
+func NewWithLanguage(code string) (*Buttifier, error) {
+	return New(WithLanguage(code))
 }
 
 // replace random syllables with buttWord
-// returns the buttified word and the number of buttified syllables
+// returns the buttified word and the number of buttified syllables.
+// like ButtifySentence, the result is always valid UTF-8
 func (b *Buttifier) ButtifyWord(word string) (string, int) {
+	s := b.newSession(word)
+	return s.buttifyWord(word, s.language)
+}
+
+func (s *session) buttifyWord(word string, language string) (string, int) {
+	word = strings.ToValidUTF8(word, string(utf8.RuneError))
 	if word == "" {
 		return "", 0
 	}
 
+	hyphenatedWord := s.hyphenateWord(word, language)
+	replaced := s.pickSyllables(hyphenatedWord)
+
 	var wordBuffer strings.Builder
 	buttCount := 0
-
-	for _, hyphenatedSyllable := range b.HyphenateWord(word).Syllables {
-		// random float between 0 and 1
-		rn := rand.New(b.RandSource).Float64()
-		if rn < b.ButtificationRate {
+	for i, hyphenatedSyllable := range hyphenatedWord.Syllables {
+		if replaced[i] {
 			// normalize buttWord's case to match currentSyllable's case
-			buttifiedSyllable := normalizeCase(hyphenatedSyllable.Letters, b.ButtWord)
-			wordBuffer.WriteString(buttifiedSyllable)
+			wordBuffer.WriteString(normalizeCase(hyphenatedSyllable.Letters, s.replacementWord(hyphenatedSyllable.Letters)))
 			buttCount++
 		} else {
 			wordBuffer.WriteString(hyphenatedSyllable.Letters)
@@ -79,118 +145,226 @@ func (b *Buttifier) ButtifyWord(word string) (string, int) {
 	return wordBuffer.String(), buttCount
 }
 
-func (b *Buttifier) HyphenateWord(word string) *hyphenatedWord {
-	breakpoints := b.hyphenator.Hyphenate(word)
+// picks which syllables of word to replace. every syllable is rolled with probability
+// ButtificationRate, and the selection strategy picks as many syllables as rolls succeeded
+func (s *session) pickSyllables(word *HyphenatedWord) []bool {
+	target := 0
+	for i := range word.Syllables {
+		// random float between 0 and 1
+		rn := s.rng.Float64()
+		if rn < s.ButtificationRate && word.Replaceable(i) {
+			target++
+		}
+	}
+	replaced := s.selectSyllables([]*HyphenatedWord{word}, target)[0]
+	if replaced == nil {
+		replaced = make([]bool, len(word.Syllables))
+	}
+	return replaced
+}
 
-	if len(breakpoints) == 0 {
-		// some words like "partne" return an empty slice, so we need to add a breakpoint
-		breakpoints = []int{len(word)}
-	} else if len(breakpoints) == 1 && breakpoints[0] == len(word)-1 {
-		// words like "asd" return []int{2}, resulting in "as" instead of "asd"
-		breakpoints[0] += 1
-	} else if breakpoints[len(breakpoints)-1] != len(word) {
-		// words with a single breakpoint like "partner" return []int{4}, resulting in "part" instead of "partner"
-		breakpoints = append(breakpoints, len(word))
+// splits word into syllables with the current language. every call returns a new
+// HyphenatedWord, so it's safe to modify even with WithCache
+func (b *Buttifier) HyphenateWord(word string) *HyphenatedWord {
+	s := b.newSession("")
+	return s.hyphenateWord(word, s.language)
+}
+
+func (s *session) hyphenateWord(word string, language string) *HyphenatedWord {
+	// breakpoints and syllable offsets are rune indices, never byte indices
+	runes := []rune(word)
+
+	syllabifier, ok := s.syllabifiers[language]
+	if !ok {
+		// the language detector wasn't sure, keep the word as a single syllable
+		return &HyphenatedWord{
+			Word:        word,
+			Breakpoints: []int{len(runes)},
+			Syllables:   []*Syllable{{Letters: word, IdxStart: 0, IdxEnd: len(runes)}},
+		}
+	}
+	split, ok := s.Dictionary.lookup(word)
+	if !ok && s.cache != nil {
+		split = s.cache.breakpoints(language, word, func() []int {
+			return syllabifier.Syllabify(word)
+		})
+	} else if !ok {
+		split = syllabifier.Syllabify(word)
+	}
+	breakpoints := graphemeBreakpoints(runes, split)
+	if len(breakpoints) == 0 || breakpoints[len(breakpoints)-1] != len(runes) {
+		breakpoints = append(breakpoints, len(runes))
 	}
 
-	var syllables []*syllable
+	var syllables []*Syllable
 	idxStart := 0
 	for _, breakpoint := range breakpoints {
-		syllables = append(syllables, &syllable{
-			Letters:  word[idxStart:breakpoint],
+		syllables = append(syllables, &Syllable{
+			Letters:  string(runes[idxStart:breakpoint]),
 			IdxStart: idxStart,
 			IdxEnd:   breakpoint,
 		})
 		idxStart = breakpoint
 	}
 
-	return &hyphenatedWord{
+	result := &HyphenatedWord{
 		Word:        word,
+		Language:    language,
 		Breakpoints: breakpoints,
 		Syllables:   syllables,
 	}
+	result.excluded = s.Exclusions.excludes(word, language, len(syllables))
+	if s.KeepSuffixes {
+		keepSuffix(result)
+	}
+	result.Stress = syllableStress(result)
+	return result
 }
 
-func (b *Buttifier) HyphenateSentence(sentence string) []*hyphenatedWord {
-	words := strings.Split(sentence, " ")
-	var result []*hyphenatedWord
-	for _, word := range words {
-		result = append(result, b.HyphenateWord(word))
+const zeroWidthJoiner = '\u200d'
+
+// moves breakpoints that would split a grapheme, like "e" followed by a
+// combining accent or an emoji joined with a zero width joiner, to the end of it.
+// breakpoints out of order or outside of the word are dropped
+func graphemeBreakpoints(runes []rune, breakpoints []int) []int {
+	var result []int
+	for _, breakpoint := range breakpoints {
+		if breakpoint <= 0 || breakpoint > len(runes) {
+			continue
+		}
+		for breakpoint < len(runes) && (unicode.IsMark(runes[breakpoint]) ||
+			runes[breakpoint] == zeroWidthJoiner || runes[breakpoint-1] == zeroWidthJoiner) {
+			breakpoint++
+		}
+		if len(result) == 0 || result[len(result)-1] < breakpoint {
+			result = append(result, breakpoint)
+		}
 	}
 	return result
 }
 
-// replace random syllables from each word with buttWord
-// returns the buttified word and true if the word was changed
-func (b *Buttifier) ButtifySentence(sentence string) string {
-	hyphenatedSentence := b.HyphenateSentence(sentence)
-	buttifiedSyllables := 0
-	totalSyllables := func() int {
-		count := 0
-		for _, hyphenatedWord := range hyphenatedSentence {
-			count += len(hyphenatedWord.Breakpoints)
-		}
-		return count
-	}()
+// hyphenates every word token of sentence, skipping whitespace, punctuation and protected tokens
+func (b *Buttifier) HyphenateSentence(sentence string) []*HyphenatedWord {
+	s := b.newSession("")
+	return s.hyphenateTokens(sentence, s.tokenize(sentence))
+}
 
-	reachedButtificationRate := func() bool {
-		return (float64(buttifiedSyllables) / float64(totalSyllables)) >= b.ButtificationRate
+// returns one HyphenatedWord per word token, in order
+func (s *session) hyphenateTokens(sentence string, tokens []Token) []*HyphenatedWord {
+	language := s.language
+	if s.LanguageDetection == DetectPerSentence {
+		language = s.detectLanguage(sentence)
 	}
 
-	unbuttifiedWords := hyphenatedSentence
-	for !reachedButtificationRate() && len(unbuttifiedWords) > 1 {
-		randomWordIdx := rand.New(b.RandSource).Int() % len(unbuttifiedWords)
-
-		buttifiedWord, buttCount := b.ButtifyWord(unbuttifiedWords[randomWordIdx].Word)
-		if buttCount > 0 {
-			unbuttifiedWords[randomWordIdx].Word = buttifiedWord
-			buttifiedSyllables += buttCount
-			// remove the word we just buttified from the slice
-			unbuttifiedWords = slices.Delete(unbuttifiedWords, randomWordIdx, randomWordIdx)
+	var result []*HyphenatedWord
+	for _, token := range tokens {
+		if !s.isWordToken(token) {
+			continue
+		}
+		if token.Kind == TokenEmote {
+			// a single syllable that can only become ButtEmote
+			length := utf8.RuneCountInString(token.Text)
+			result = append(result, &HyphenatedWord{
+				Word:        token.Text,
+				Breakpoints: []int{length},
+				Syllables:   []*Syllable{{Letters: token.Text, IdxStart: 0, IdxEnd: length}},
+				emote:       true,
+			})
+			continue
 		}
+		if s.LanguageDetection == DetectPerWord {
+			language = s.detectLanguage(token.Text)
+		}
+		result = append(result, s.hyphenateWord(token.Text, language))
 	}
+	return result
+}
 
-	result := []string{}
+// whether token gets hyphenated and may be replaced
+func (s *session) isWordToken(token Token) bool {
+	return token.Kind == TokenWord || (token.Kind == TokenEmote && s.ButtEmote != "")
+}
+
+// replace random syllables from each word with buttWord
+// returns the buttified sentence, which is always valid UTF-8:
+// invalid bytes in sentence are replaced with U+FFFD
+func (b *Buttifier) ButtifySentence(sentence string) string {
+	return b.Buttify(sentence).Output
+}
+
+// same as ButtifySentence, but also reports every replaced syllable
+func (b *Buttifier) Buttify(text string) Result {
+	return b.buttify(nil, text, text)
+}
+
+// key identifies the message for the seeded mode, see Config.Seeded.
+// o replaces b's settings when it isn't nil
+func (b *Buttifier) buttify(o *overrides, key string, text string) Result {
+	text = strings.ToValidUTF8(text, string(utf8.RuneError))
+	s := b.newSessionWithOverrides(o, key)
+	tokens := s.tokenize(text)
+	return s.buttifyTokens(text, tokens, s.hyphenateTokens(text, tokens))
+}
+
+func (s *session) buttifyTokens(text string, tokens []Token, hyphenatedSentence []*HyphenatedWord) Result {
+	// words the language detector wasn't sure about and kept suffixes are left alone
+	eligible := 0
 	for _, hyphenatedWord := range hyphenatedSentence {
-		result = append(result, hyphenatedWord.Word)
+		for syllableIdx := range hyphenatedWord.Syllables {
+			if hyphenatedWord.Replaceable(syllableIdx) {
+				eligible++
+			}
+		}
 	}
 
-	return strings.Join(result, " ")
+	// replaced[i][j] is true when syllable j of word i gets replaced
+	replaced := s.selectSyllables(hyphenatedSentence, s.targetReplacements(eligible))
+	return s.buildResult(text, tokens, hyphenatedSentence, replaced)
 }
 
 func (b *Buttifier) ToButtOrNotToButt() bool {
-	rn := rand.New(b.RandSource).Float64()
-	return rn < b.ButtificationProbability
+	return b.newSession("").toButtOrNotToButt()
+}
+
+func (s *session) toButtOrNotToButt() bool {
+	rn := s.rng.Float64()
+	return rn < s.ButtificationProbability
 }
 
 // tries to normalize buttWord's case to match currentSyllable's case
 // ("SOMeone", "buttbutt") -> "BUTtbutt"
 // ("SOMEone", "buttbutt") -> "BUTTbutt"
+// ("ÉCOle", "butt") -> "BUTT"
 func normalizeCase(currentSyllable string, buttWord string) string {
-	buttifiedSyllable := strings.Split(buttWord, "")
+	syllableRunes := []rune(currentSyllable)
+	buttifiedSyllable := []rune(buttWord)
 
-	if len(currentSyllable) < len(buttWord) {
+	if len(syllableRunes) < len(buttifiedSyllable) {
 		isAllUpperCase := true
-		for i := 0; i < len(currentSyllable); i++ {
-			if !unicode.IsUpper(rune(currentSyllable[i])) {
+		hasLetters := false
+		for _, r := range syllableRunes {
+			if !unicode.IsLetter(r) {
+				continue
+			}
+			hasLetters = true
+			if !unicode.IsUpper(r) {
 				isAllUpperCase = false
 				break
 			}
 		}
-		if isAllUpperCase {
-			return strings.ToUpper(strings.Join(buttifiedSyllable, ""))
+		if hasLetters && isAllUpperCase {
+			return strings.ToUpper(buttWord)
 		}
 	}
 
 	// copies case character by character
-	for i := 0; i < min(len(buttWord), len(currentSyllable)); i++ {
-		letterIsUpperCase := unicode.IsUpper(rune(currentSyllable[i]))
-		if letterIsUpperCase {
-			buttifiedSyllable[i] = strings.ToUpper(buttifiedSyllable[i])
+	for i := 0; i < min(len(buttifiedSyllable), len(syllableRunes)); i++ {
+		if unicode.IsUpper(syllableRunes[i]) {
+			buttifiedSyllable[i] = unicode.ToUpper(buttifiedSyllable[i])
 		} else {
-			buttifiedSyllable[i] = strings.ToLower(buttifiedSyllable[i])
+			buttifiedSyllable[i] = unicode.ToLower(buttifiedSyllable[i])
 		}
 	}
 
-	return strings.Join(buttifiedSyllable, "")
+	return string(buttifiedSyllable)
 }
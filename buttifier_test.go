@@ -2,8 +2,10 @@ package buttifier
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 type UnitTestRandSource struct{}
@@ -45,7 +47,7 @@ func TestButtifySentence(t *testing.T) {
 	}
 	resultMap := map[string]string{
 		"grinding for partner":     "buttbutt for partner",
-		"frizze5Wade laffer curve": "butt laffer curve",
+		"frizze5Wade laffer curve": "butt buttfer curve",
 	}
 	for sentence, expected := range resultMap {
 		actual := b.ButtifySentence(sentence)
@@ -108,3 +110,71 @@ func TestHyphenateWord(t *testing.T) {
 		}
 	}
 }
+
+func TestHyphenateWordUsesRuneOffsets(t *testing.T) {
+	b, err := NewWithLanguage("pt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hyphenatedWord := b.HyphenateWord("coração")
+	expected := []string{"co", "ra", "ção"}
+	if len(hyphenatedWord.Syllables) != len(expected) {
+		t.Fatalf("expected %v, got %s", expected, joinSyllables(hyphenatedWord))
+	}
+	runes := []rune(hyphenatedWord.Word)
+	for i, syllable := range hyphenatedWord.Syllables {
+		if syllable.Letters != expected[i] {
+			t.Errorf("expected syllable %d to be %s, got %s", i, expected[i], syllable.Letters)
+		}
+		if string(runes[syllable.IdxStart:syllable.IdxEnd]) != syllable.Letters {
+			t.Errorf("syllable %s has wrong rune offsets %d:%d", syllable.Letters, syllable.IdxStart, syllable.IdxEnd)
+		}
+	}
+
+}
+
+func TestGraphemeBreakpoints(t *testing.T) {
+	// "e" + combining acute accent, and a family emoji joined with zero width joiners
+	runes := []rune("cafe\u0301s 👩\u200d👧")
+	actual := graphemeBreakpoints(runes, []int{4, 5, 8, 9})
+	expected := []int{5, 10}
+	if !slices.Equal(expected, actual) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestButtifyWordKeepsCaseNonASCII(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	resultMap := map[string]string{
+		"Ça":    "Butt",
+		"ÉCOLE": "BUTTBUTT",
+		"émile": "butt",
+	}
+	for word, expected := range resultMap {
+		actual, _ := b.ButtifyWord(word)
+		if expected != actual {
+			t.Errorf("expected %s => %s, got %s => %s", word, expected, word, actual)
+		}
+	}
+}
+
+func TestButtifySentenceIsValidUTF8(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtWord = "bündchen"
+
+	for _, sentence := range []string{"grinding \xff for partner", "ÉCOLE façade naïve", "\xe2\x82"} {
+		actual := b.ButtifySentence(sentence)
+		if !utf8.ValidString(actual) {
+			t.Errorf("expected valid UTF-8 for %q, got %q", sentence, actual)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package buttifier
+
+import (
+	"container/list"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// hit and miss counters of the cache enabled with WithCache
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+	// words in the cache right now, and the most it holds
+	Len  int
+	Size int
+}
+
+// least recently used breakpoints of words, keyed by language and lowercase word.
+// TeX patterns and PhoneticSyllabifier don't depend on case, so "Pog" and "pog"
+// share an entry. cached slices are never modified or handed out, only copies are
+type hyphenationCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[cacheKey]*list.Element
+	// most recently used first
+	order  *list.List
+	hits   uint64
+	misses uint64
+}
+
+type cacheKey struct {
+	language string
+	word     string
+}
+
+type cacheEntry struct {
+	key         cacheKey
+	breakpoints []int
+}
+
+func newHyphenationCache(size int) *hyphenationCache {
+	return &hyphenationCache{size: size, entries: map[cacheKey]*list.Element{}, order: list.New()}
+}
+
+// returns the breakpoints of word, calling syllabify and caching its result on a miss
+func (c *hyphenationCache) breakpoints(language string, word string, syllabify func() []int) []int {
+	key := cacheKey{language, strings.ToLower(word)}
+
+	c.mu.Lock()
+	if element, ok := c.entries[key]; ok {
+		c.hits++
+		c.order.MoveToFront(element)
+		breakpoints := slices.Clone(element.Value.(*cacheEntry).breakpoints)
+		c.mu.Unlock()
+		return breakpoints
+	}
+	c.misses++
+	c.mu.Unlock()
+
+	// syllabify outside of the lock, two goroutines missing the same word at once
+	// both compute it, which is cheaper than making every other word wait
+	breakpoints := syllabify()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		c.order.MoveToFront(element)
+		return breakpoints
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key, slices.Clone(breakpoints)})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).key)
+	}
+	return breakpoints
+}
+
+func (c *hyphenationCache) stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return CacheStats{Hits: c.hits, Misses: c.misses, Len: c.order.Len(), Size: c.size}
+}
+
+// hit and miss counters of the cache, all zero without WithCache
+func (b *Buttifier) CacheStats() CacheStats {
+	if b.cache == nil {
+		return CacheStats{}
+	}
+	return b.cache.stats()
+}
@@ -0,0 +1,104 @@
+package buttifier
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestHyphenationCache(t *testing.T) {
+	b, err := New(WithCache(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, word := range []string{"partner", "Partner", "developer", "partner", "something", "developer"} {
+		b.HyphenateWord(word)
+	}
+	// "partner" hits twice, then "something" evicts "developer"
+	expected := CacheStats{Hits: 2, Misses: 4, Len: 2, Size: 2}
+	if stats := b.CacheStats(); stats != expected {
+		t.Errorf("expected %+v, got %+v", expected, stats)
+	}
+
+	if actual := joinSyllables(b.HyphenateWord("PARTNER")); actual != "PART-NER" {
+		t.Errorf("expected cached breakpoints to keep the word's case, got %s", actual)
+	}
+}
+
+func TestHyphenationCacheIsolation(t *testing.T) {
+	b, err := New(WithCache(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	word := b.HyphenateWord("developer")
+	word.Word = "modified"
+	word.Breakpoints[0] = 100
+	word.Syllables[0].Letters = "modified"
+
+	if actual := joinSyllables(b.HyphenateWord("developer")); actual != "de-vel-op-er" {
+		t.Errorf("expected modifying a result to leave the cache alone, got %s", actual)
+	}
+	if stats := b.CacheStats(); stats.Hits != 1 {
+		t.Errorf("expected a hit, got %+v", stats)
+	}
+}
+
+func TestHyphenationCacheConcurrent(t *testing.T) {
+	b, err := New(WithCache(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				b.ButtifySentence(fmt.Sprintf("grinding for partner %d developers", j%12))
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := b.CacheStats()
+	if stats.Len > stats.Size || stats.Hits+stats.Misses != 8*100*5 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestCacheStatsWithoutCache(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.HyphenateWord("partner")
+	if stats := b.CacheStats(); stats != (CacheStats{}) {
+		t.Errorf("expected empty stats, got %+v", stats)
+	}
+}
+
+func BenchmarkHyphenateWordCached(b *testing.B) {
+	buttifier, err := New(WithCache(1000))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buttifier.HyphenateWord("developers")
+	}
+}
+
+func BenchmarkHyphenateWord(b *testing.B) {
+	buttifier, err := New()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buttifier.HyphenateWord("developers")
+	}
+}
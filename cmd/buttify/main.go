@@ -0,0 +1,103 @@
+// buttify replaces syllables of each line read from its arguments or stdin
+//
+//	buttify -rate 0.5 "Someone did that something something"
+//	cat chat.log | buttify -word booty -seed 42
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/douglascdev/buttifier"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("buttify", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: buttify [flags] [text ...]")
+		fmt.Fprintln(stderr, "buttifies the text given as arguments, or each line read from stdin")
+		flags.PrintDefaults()
+	}
+	word := flags.String("word", "butt", "what each buttified syllable is replaced with")
+	words := flags.String("words", "", `weighted replacement words like "butt:10,booty:2,bum", overrides -word`)
+	rate := flags.Float64("rate", 0.3, "fraction of syllables to buttify")
+	probability := flags.Float64("probability", 1, "chance of buttifying each line at all")
+	keepSuffixes := flags.Bool("keep-suffixes", false, `keep suffixes like -s or -ing, so "developers" becomes "develbutts"`)
+	splitIdentifiers := flags.Bool("split-identifiers", false, `buttify each part of identifiers like "getUserName" or "snake_case"`)
+	syllables := flags.String("syllables", "", `file with syllable splits like "pog-champ", one per line`)
+	stressed := flags.Bool("stressed", false, "replace stressed syllables first")
+	emotes := flags.String("emotes", "", "file with emote codes to leave alone, separated by whitespace")
+	seed := flags.Uint64("seed", 0, "make the output reproducible for this seed")
+	phonetic := flags.Bool("phonetic", false, "split syllables by how they sound instead of with TeX hyphenation patterns")
+	language := flags.String("lang", buttifier.DefaultLanguage, "hyphenation language, one of "+strings.Join(buttifier.Languages(), ", "))
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	options := []buttifier.Option{buttifier.WithLanguage(*language)}
+	if *phonetic {
+		options = append(options, buttifier.WithSyllabifier(buttifier.PhoneticSyllabifier{SilentFinalE: *language == "en"}))
+	}
+	b, err := buttifier.New(options...)
+	if err != nil {
+		fmt.Fprintln(stderr, "buttify:", err)
+		return 1
+	}
+	b.ButtWord = *word
+	if *words != "" {
+		b.ButtWords, err = buttifier.ParseWeightedWords(*words)
+		if err != nil {
+			fmt.Fprintln(stderr, "buttify:", err)
+			return 2
+		}
+	}
+	b.ButtificationRate = *rate
+	b.ButtificationProbability = *probability
+	b.KeepSuffixes = *keepSuffixes
+	b.SplitIdentifiers = *splitIdentifiers
+	b.PreferStressed = *stressed
+	if *syllables != "" {
+		b.Dictionary, err = buttifier.LoadSyllableDictionary(*syllables)
+		if err != nil {
+			fmt.Fprintln(stderr, "buttify:", err)
+			return 1
+		}
+	}
+	if *emotes != "" {
+		b.Emotes, err = buttifier.LoadEmoteSetFile(*emotes)
+		if err != nil {
+			fmt.Fprintln(stderr, "buttify:", err)
+			return 1
+		}
+	}
+	flags.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			b.Seeded = true
+			b.Seed = *seed
+		}
+	})
+
+	if flags.NArg() > 0 {
+		fmt.Fprintln(stdout, b.MaybeButtify(strings.Join(flags.Args(), " ")).Output)
+		return 0
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		fmt.Fprintln(stdout, b.MaybeButtify(scanner.Text()).Output)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(stderr, "buttify:", err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunArguments(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-rate", "1", "-word", "booty", "grinding", "for"}, strings.NewReader(""), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if stdout.String() != "bootybooty booty\n" {
+		t.Errorf("expected bootybooty booty, got %q", stdout.String())
+	}
+}
+
+func TestRunWeightedWords(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-rate", "1", "-words", "booty:1,bum:0", "grinding"}, strings.NewReader(""), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if stdout.String() != "bootybooty\n" {
+		t.Errorf("expected bootybooty, got %q", stdout.String())
+	}
+
+	if code := run([]string{"-words", "booty:x", "grinding"}, strings.NewReader(""), &stdout, &stderr); code != 2 {
+		t.Errorf("expected exit code 2 for an invalid list, got %d", code)
+	}
+}
+
+func TestRunStdin(t *testing.T) {
+	input := "grinding for partner\nsomeone developers computer\n"
+
+	var first, second, stderr bytes.Buffer
+	for _, stdout := range []*bytes.Buffer{&first, &second} {
+		code := run([]string{"-seed", "7", "-rate", "0.5"}, strings.NewReader(input), stdout, &stderr)
+		if code != 0 {
+			t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+		}
+	}
+	if lines := strings.Count(first.String(), "\n"); lines != 2 {
+		t.Errorf("expected one output line per input line, got %q", first.String())
+	}
+	if first.String() != second.String() {
+		t.Errorf("expected the same seed to give the same output, got %q and %q", first.String(), second.String())
+	}
+	if first.String() == input {
+		t.Error("expected the input to be buttified")
+	}
+}
+
+func TestRunProbabilityZero(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	run([]string{"-probability", "0"}, strings.NewReader("grinding for partner\n"), &stdout, &stderr)
+	if stdout.String() != "grinding for partner\n" {
+		t.Errorf("expected the line to be left alone, got %q", stdout.String())
+	}
+}
+
+func TestRunUnknownLanguage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"-lang", "xx", "hello"}, strings.NewReader(""), &stdout, &stderr); code != 1 {
+		t.Errorf("expected exit code 1, got %d", code)
+	}
+	if !strings.Contains(stderr.String(), "unknown language") {
+		t.Errorf("expected an unknown language error, got %q", stderr.String())
+	}
+}
+
+func TestRunEmotes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "emotes.txt")
+	if err := os.WriteFile(path, []byte("KEKW\nPogChamp\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-rate", "1", "-emotes", path, "grinding", "KEKW"}, strings.NewReader(""), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if stdout.String() != "buttbutt KEKW\n" {
+		t.Errorf("expected buttbutt KEKW, got %q", stdout.String())
+	}
+
+	if code := run([]string{"-emotes", filepath.Join(t.TempDir(), "missing.txt"), "grinding"}, strings.NewReader(""), &stdout, &stderr); code != 1 {
+		t.Errorf("expected exit code 1 for a missing file, got %d", code)
+	}
+}
+
+func TestRunPhonetic(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-rate", "1", "-phonetic", "banana"}, strings.NewReader(""), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if stdout.String() != "buttbuttbutt\n" {
+		t.Errorf("expected buttbuttbutt, got %q", stdout.String())
+	}
+}
+
+func TestRunSyllables(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "syllables.txt")
+	if err := os.WriteFile(path, []byte("pog-champ\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-rate", "1", "-syllables", path, "pogchamp"}, strings.NewReader(""), &stdout, &stderr)
+	if code != 0 {
+		t.Fatalf("expected exit code 0, got %d: %s", code, stderr.String())
+	}
+	if stdout.String() != "buttbutt\n" {
+		t.Errorf("expected buttbutt, got %q", stdout.String())
+	}
+}
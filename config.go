@@ -0,0 +1,153 @@
+package buttifier
+
+import (
+	"math/rand/v2"
+	"sync"
+)
+
+// settings read by every call, see Buttifier for how to change them safely
+type Config struct {
+	ButtWord string
+	// replacement words picked at random by weight, ButtWord is used when empty.
+	// don't modify the slice after handing it to a Buttifier
+	ButtWords []WeightedWord
+	// whether a word from ButtWords is picked per syllable or once per message
+	WordChoice WordChoice
+	// with ChoosePerSyllable, favor words closer in length to the syllable they replace
+	PreferSimilarLength bool
+
+	ButtificationProbability float64
+	ButtificationRate        float64
+	// keep inflectional suffixes like -s, -ing or -ed after the last replaced
+	// syllable, so "developers" becomes "develbutts"
+	KeepSuffixes bool
+	// hyphenate the parts of identifiers like "getUserName", "snake_case" or "well-known"
+	// separately. hashtags like "#MakeItButt" are split too when they're in Unprotect
+	SplitIdentifiers bool
+	// splits that override the syllabifier, like "pog-champ"
+	Dictionary *SyllableDictionary
+	// which syllables get replaced, nil means SelectUniform
+	Selection SelectionStrategy
+	// shorthand for Selection = SelectStressed{}: replace syllables with primary stress
+	// first, then secondary stress, according to the pronunciation dictionary.
+	// ignored when Selection is set
+	PreferStressed bool
+	// words that are never buttified
+	Exclusions Exclusions
+	// emote codes that are never buttified, nil disables emote detection.
+	// use EmoteRegistry.Emotes to keep them in sync with BTTV, FFZ or 7TV exports
+	Emotes *EmoteSet
+	// when set, emotes from Emotes are replaced with this emote like a one syllable word
+	ButtEmote string
+	// kinds from ProtectedTokens that are buttified like regular words anyway,
+	// e.g. TokenHashtag. 0 protects all of them
+	Unprotect TokenKind
+	// how ButtificationRate * syllables is rounded to a whole number of replacements
+	Rounding Rounding
+	// upper bound for replaced syllables per sentence, 0 means no limit
+	MaxReplacements int
+	// messages that don't pass these are skipped by MaybeButtify, 0 disables each rule
+	MinWords     int
+	MinSyllables int
+	// maximum message length in runes
+	MaxLength int
+	// whether ButtifySentence detects the language of each sentence or word
+	// among the languages loaded with AddLanguage. only en, pt, es and de can be
+	// detected, text in any other loaded language is left alone
+	LanguageDetection DetectionMode
+	// sentences or words detected with a lower confidence are left alone
+	DetectionThreshold float64
+	// when true, RandSource is ignored and every call draws from a PCG stream seeded
+	// with Seed and a hash of the message (or its ID for ButtifyMessage), so the
+	// output only depends on (Seed, message, Config)
+	Seeded bool
+	Seed   uint64
+}
+
+// returns a copy of the current settings
+func (b *Buttifier) Snapshot() Config {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.Config
+}
+
+// changes settings while other goroutines may be using b.
+// calls that already started keep using the settings they started with
+func (b *Buttifier) Update(fn func(*Config)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	config := b.Config
+	fn(&config)
+	b.Config = config
+}
+
+// everything a single call reads, copied from the Buttifier when the call starts
+type session struct {
+	Config
+	language     string
+	syllabifiers map[string]Syllabifier
+	cache        *hyphenationCache
+	// random stream used for the whole call
+	rng *rand.Rand
+	// the word picked for the whole message with ChoosePerMessage
+	messageWord string
+}
+
+// settings that replace the Buttifier's for a single call, see Profiles
+type overrides struct {
+	config Config
+	// "" keeps the Buttifier's language
+	language string
+}
+
+// key identifies the message being buttified, it's only used in seeded mode
+func (b *Buttifier) newSession(key string) *session {
+	return b.newSessionWithOverrides(nil, key)
+}
+
+// same as newSession, but uses o instead of b's settings when it isn't nil
+func (b *Buttifier) newSessionWithOverrides(o *overrides, key string) *session {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	s := &session{
+		Config:       b.Config,
+		language:     b.language,
+		syllabifiers: b.syllabifiers,
+		cache:        b.cache,
+	}
+	if o != nil {
+		s.Config = o.config
+		if o.language != "" {
+			s.language = o.language
+		}
+	}
+
+	if s.Seeded {
+		s.rng = rand.New(seededSource(s.Seed, key))
+		return s
+	}
+
+	var source rand.Source = DefaultRandSource{}
+	if b.RandSource != nil {
+		source = b.RandSource
+	}
+	if _, ok := source.(DefaultRandSource); !ok {
+		// custom sources like rand.PCG aren't safe for concurrent use
+		source = &lockedSource{mu: &b.randMu, source: source}
+	}
+	s.rng = rand.New(source)
+	return s
+}
+
+// serializes calls to a rand.Source shared by every call of a Buttifier
+type lockedSource struct {
+	mu     *sync.Mutex
+	source rand.Source
+}
+
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.source.Uint64()
+}
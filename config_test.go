@@ -0,0 +1,70 @@
+package buttifier
+
+import (
+	"math/rand/v2"
+	"sync"
+	"testing"
+)
+
+// run with -race to check the concurrency guarantees documented on Buttifier
+func TestButtifyConcurrently(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// not safe for concurrent use on its own
+	b.RandSource = rand.NewPCG(1, 2)
+	b.ButtificationProbability = 1
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				b.Buttify("grinding for partner, someone developers computer")
+				b.MaybeButtify("grinding for partner")
+				b.ButtifyWord("developers")
+				b.HyphenateSentence("someone developers computer")
+				b.ToButtOrNotToButt()
+			}
+		}()
+		if i%2 == 0 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				b.Update(func(c *Config) {
+					c.ButtificationRate = 0.5
+					c.ButtWord = "booty"
+				})
+				if err := b.AddLanguage("pt"); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+
+	if config := b.Snapshot(); config.ButtWord != "booty" || config.ButtificationRate != 0.5 {
+		t.Errorf("expected Update to change the settings, got %+v", config)
+	}
+}
+
+func TestUpdateDoesNotAffectRunningSnapshot(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := b.newSession("")
+	b.Update(func(c *Config) {
+		c.ButtWord = "booty"
+	})
+	if s.ButtWord != "butt" {
+		t.Errorf("expected the snapshot to keep butt, got %s", s.ButtWord)
+	}
+	if actual := b.ButtifySentence("grinding for partner"); actual != "bootybooty for partner" {
+		t.Errorf("expected bootybooty for partner, got %s", actual)
+	}
+}
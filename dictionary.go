@@ -0,0 +1,162 @@
+package buttifier
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var ErrInvalidSplit = errors.New("buttifier: invalid syllable split")
+
+// explicit syllable splits for words the syllabifier gets wrong, like chat slang or
+// streamer names. set it in Config.Dictionary to use it before the syllabifier.
+// safe for concurrent use
+type SyllableDictionary struct {
+	mu sync.RWMutex
+	// breakpoints of lowercase words
+	words map[string][]int
+}
+
+func NewSyllableDictionary() *SyllableDictionary {
+	return &SyllableDictionary{words: map[string][]int{}}
+}
+
+// adds words split with hyphens, like "pog-champ", replacing previous splits of the same word.
+// words match regardless of case
+func (d *SyllableDictionary) Add(splits ...string) error {
+	parsed := map[string][]int{}
+	for _, split := range splits {
+		word, breakpoints := parseException(split)
+		if word == "" || strings.ContainsFunc(word, unicode.IsSpace) {
+			return fmt.Errorf("%w: %q", ErrInvalidSplit, split)
+		}
+		parsed[word] = breakpoints
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for word, breakpoints := range parsed {
+		d.words[word] = breakpoints
+	}
+	return nil
+}
+
+func (d *SyllableDictionary) Remove(words ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, word := range words {
+		delete(d.words, strings.ToLower(word))
+	}
+}
+
+// the rune offsets where each syllable of word ends, if word is in the dictionary
+func (d *SyllableDictionary) Lookup(word string) ([]int, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	breakpoints, ok := d.words[strings.ToLower(word)]
+	return slices.Clone(breakpoints), ok
+}
+
+// same as Lookup, but d may be nil
+func (d *SyllableDictionary) lookup(word string) ([]int, bool) {
+	if d == nil {
+		return nil, false
+	}
+	return d.Lookup(word)
+}
+
+func (d *SyllableDictionary) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return len(d.words)
+}
+
+// adds the splits in r, one per line like "pog-champ". blank lines and lines
+// starting with # are skipped. nothing is added if any line is invalid
+func (d *SyllableDictionary) Import(r io.Reader) error {
+	var splits []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		splits = append(splits, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return d.Add(splits...)
+}
+
+// writes every split in the format read by Import, sorted by word
+func (d *SyllableDictionary) Export(w io.Writer) error {
+	d.mu.RLock()
+	var lines []string
+	for word, breakpoints := range d.words {
+		lines = append(lines, formatSplit(word, breakpoints))
+	}
+	d.mu.RUnlock()
+	slices.Sort(lines)
+
+	buffered := bufio.NewWriter(w)
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(buffered, line); err != nil {
+			return err
+		}
+	}
+	return buffered.Flush()
+}
+
+// reads a dictionary written by Save
+func LoadSyllableDictionary(path string) (*SyllableDictionary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	d := NewSyllableDictionary()
+	if err := d.Import(file); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return d, nil
+}
+
+// writes the dictionary to path, replacing the file at once so a crash
+// never leaves it half written
+func (d *SyllableDictionary) Save(path string) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if err := d.Export(file); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+// "pogchamp", [3 8] -> "pog-champ"
+func formatSplit(word string, breakpoints []int) string {
+	runes := []rune(word)
+	var parts []string
+	start := 0
+	for _, breakpoint := range breakpoints {
+		parts = append(parts, string(runes[start:breakpoint]))
+		start = breakpoint
+	}
+	return strings.Join(parts, "-")
+}
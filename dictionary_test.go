@@ -0,0 +1,117 @@
+package buttifier
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSyllableDictionary(t *testing.T) {
+	dictionary := NewSyllableDictionary()
+	if err := dictionary.Add("pog-champ", "POG-GERS", "sadge"); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Dictionary = dictionary
+
+	resultMap := map[string]string{
+		"pogchamp": "pog-champ",
+		"PogChamp": "Pog-Champ",
+		"poggers":  "pog-gers",
+		"sadge":    "sadge",
+		"partner":  "part-ner",
+	}
+	for word, expected := range resultMap {
+		if actual := joinSyllables(b.HyphenateWord(word)); actual != expected {
+			t.Errorf("expected %s => %s, got %s", word, expected, actual)
+		}
+	}
+
+	b.ButtificationRate = 1
+	if actual := b.ButtifySentence("PogChamp sadge"); actual != "ButtButt butt" {
+		t.Errorf("expected ButtButt butt, got %s", actual)
+	}
+
+	dictionary.Remove("PogChamp")
+	if _, ok := dictionary.Lookup("pogchamp"); ok {
+		t.Error("expected pogchamp to be removed")
+	}
+	if err := dictionary.Add("pog champ"); !errors.Is(err, ErrInvalidSplit) {
+		t.Errorf("expected ErrInvalidSplit, got %v", err)
+	}
+	if err := dictionary.Add("-"); !errors.Is(err, ErrInvalidSplit) {
+		t.Errorf("expected ErrInvalidSplit, got %v", err)
+	}
+}
+
+func TestSyllableDictionaryImportExport(t *testing.T) {
+	dictionary := NewSyllableDictionary()
+	input := "# chat slang\npog-champ\n\n  kek-w  \nmon-ka-s\n"
+	if err := dictionary.Import(strings.NewReader(input)); err != nil {
+		t.Fatal(err)
+	}
+	if dictionary.Len() != 3 {
+		t.Errorf("expected 3 words, got %d", dictionary.Len())
+	}
+	if breakpoints, _ := dictionary.Lookup("monkas"); !slices.Equal(breakpoints, []int{3, 5, 6}) {
+		t.Errorf("expected [3 5 6], got %v", breakpoints)
+	}
+
+	var output bytes.Buffer
+	if err := dictionary.Export(&output); err != nil {
+		t.Fatal(err)
+	}
+	if output.String() != "kek-w\nmon-ka-s\npog-champ\n" {
+		t.Errorf("unexpected export %q", output.String())
+	}
+
+	if err := dictionary.Import(strings.NewReader("sad-ge\nbad split\n")); err == nil {
+		t.Error("expected an error for an invalid line")
+	}
+	if _, ok := dictionary.Lookup("sadge"); ok {
+		t.Error("expected a failed import to add nothing")
+	}
+}
+
+func TestSyllableDictionarySaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "syllables.txt")
+	dictionary := NewSyllableDictionary()
+	if err := dictionary.Add("pog-champ", "sad-ge"); err != nil {
+		t.Fatal(err)
+	}
+	if err := dictionary.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadSyllableDictionary(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, word := range []string{"pogchamp", "sadge"} {
+		expected, _ := dictionary.Lookup(word)
+		if actual, ok := loaded.Lookup(word); !ok || !slices.Equal(actual, expected) {
+			t.Errorf("expected %s to be saved as %v, got %v", word, expected, actual)
+		}
+	}
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected no temporary files to be left, got %v", entries)
+	}
+
+	if _, err := LoadSyllableDictionary(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
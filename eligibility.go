@@ -0,0 +1,89 @@
+package buttifier
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// why MaybeButtify left a message alone
+type SkipReason int
+
+const (
+	// the message was not skipped, although it may still have no replaced syllables
+	NotSkipped SkipReason = iota
+	// ToButtOrNotToButt decided not to butt
+	SkippedByProbability
+	// fewer words than MinWords
+	SkippedTooFewWords
+	// fewer syllables than MinSyllables
+	SkippedTooFewSyllables
+	// more runes than MaxLength
+	SkippedTooLong
+)
+
+func (r SkipReason) String() string {
+	switch r {
+	case NotSkipped:
+		return "not skipped"
+	case SkippedByProbability:
+		return "skipped by probability"
+	case SkippedTooFewWords:
+		return "too few words"
+	case SkippedTooFewSyllables:
+		return "too few syllables"
+	case SkippedTooLong:
+		return "too long"
+	default:
+		return "unknown"
+	}
+}
+
+// checks the eligibility rules and ButtificationProbability, then buttifies text.
+// when the message is skipped, Result.Output is the input and Result.Skipped says why
+func (b *Buttifier) MaybeButtify(text string) Result {
+	return b.maybeButtify(nil, text, text)
+}
+
+// key identifies the message for the seeded mode, see Config.Seeded.
+// o replaces b's settings when it isn't nil
+func (b *Buttifier) maybeButtify(o *overrides, key string, text string) Result {
+	text = strings.ToValidUTF8(text, string(utf8.RuneError))
+	s := b.newSessionWithOverrides(o, key)
+	skip := func(reason SkipReason) Result {
+		return Result{Input: text, Output: text, Skipped: reason}
+	}
+
+	if s.MaxLength > 0 && utf8.RuneCountInString(text) > s.MaxLength {
+		return skip(SkippedTooLong)
+	}
+
+	tokens := s.tokenize(text)
+	hyphenatedSentence := s.hyphenateTokens(text, tokens)
+
+	// only words and syllables the buttifier could replace are counted
+	words, syllables := 0, 0
+	for _, hyphenatedWord := range hyphenatedSentence {
+		replaceable := 0
+		for i := range hyphenatedWord.Syllables {
+			if hyphenatedWord.Replaceable(i) {
+				replaceable++
+			}
+		}
+		if replaceable > 0 {
+			words++
+			syllables += replaceable
+		}
+	}
+	if words < s.MinWords {
+		return skip(SkippedTooFewWords)
+	}
+	if syllables < s.MinSyllables {
+		return skip(SkippedTooFewSyllables)
+	}
+
+	if !s.toButtOrNotToButt() {
+		return skip(SkippedByProbability)
+	}
+
+	return s.buttifyTokens(text, tokens, hyphenatedSentence)
+}
@@ -0,0 +1,53 @@
+package buttifier
+
+import (
+	"testing"
+)
+
+func TestMaybeButtify(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := b.MaybeButtify("grinding for partner")
+	if result.Skipped != NotSkipped || result.Output != "buttbutt for partner" {
+		t.Errorf("expected buttbutt for partner, got %q (%s)", result.Output, result.Skipped)
+	}
+
+	b.ButtificationProbability = 0
+	result = b.MaybeButtify("grinding for partner")
+	if result.Skipped != SkippedByProbability || result.Changed || result.Output != "grinding for partner" {
+		t.Errorf("expected the message to be skipped by probability, got %+v", result)
+	}
+}
+
+func TestMaybeButtifyEligibility(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationProbability = 1
+	b.MinWords = 3
+	b.MinSyllables = 5
+	b.MaxLength = 30
+
+	resultMap := map[string]SkipReason{
+		"grinding for partner": NotSkipped,
+		"grinding partner":     SkippedTooFewWords,
+		"go for it":            SkippedTooFewSyllables,
+		"grinding for partner the whole night long": SkippedTooLong,
+		"hi, :) !!": SkippedTooFewWords,
+	}
+	for text, expected := range resultMap {
+		result := b.MaybeButtify(text)
+		if result.Skipped != expected {
+			t.Errorf("expected %q to be %s, got %s", text, expected, result.Skipped)
+		}
+		if result.Skipped != NotSkipped && result.Output != text {
+			t.Errorf("expected skipped %q to be unchanged, got %q", text, result.Output)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package buttifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var ErrUnknownEmoteFormat = errors.New("buttifier: unknown emote format")
+
+// JSON formats of the third-party emote services' APIs, saved to disk
+type EmoteFormat int
+
+const (
+	// BetterTTV, either a list of emotes like /3/cached/emotes/global or a user
+	// like /3/cached/users/twitch/{id} with channelEmotes and sharedEmotes.
+	// BetterTTV's cached FrankerFaceZ lists use the same format
+	EmoteFormatBTTV EmoteFormat = iota
+	// FrankerFaceZ, a room like /v1/room/{name} or /v1/set/global, with emoticons grouped in sets
+	EmoteFormatFFZ
+	// 7TV, an emote set like /v3/emote-sets/{id} or a user like /v3/users/twitch/{id}
+	EmoteFormat7TV
+)
+
+func (f EmoteFormat) String() string {
+	switch f {
+	case EmoteFormatBTTV:
+		return "bttv"
+	case EmoteFormatFFZ:
+		return "ffz"
+	case EmoteFormat7TV:
+		return "7tv"
+	default:
+		return "unknown"
+	}
+}
+
+// returns the emote codes in an export of the given format
+func LoadEmotes(r io.Reader, format EmoteFormat) ([]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	switch format {
+	case EmoteFormatBTTV:
+		type emote struct {
+			Code string `json:"code"`
+		}
+		var user struct {
+			ChannelEmotes []emote `json:"channelEmotes"`
+			SharedEmotes  []emote `json:"sharedEmotes"`
+		}
+		if isJSONArray(data) {
+			err = json.Unmarshal(data, &user.ChannelEmotes)
+		} else {
+			err = json.Unmarshal(data, &user)
+		}
+		for _, emote := range append(user.ChannelEmotes, user.SharedEmotes...) {
+			codes = append(codes, emote.Code)
+		}
+	case EmoteFormatFFZ:
+		var room struct {
+			Sets map[string]struct {
+				Emoticons []struct {
+					Name string `json:"name"`
+				} `json:"emoticons"`
+			} `json:"sets"`
+		}
+		err = json.Unmarshal(data, &room)
+		for _, set := range room.Sets {
+			for _, emote := range set.Emoticons {
+				codes = append(codes, emote.Name)
+			}
+		}
+	case EmoteFormat7TV:
+		type emote struct {
+			Name string `json:"name"`
+		}
+		// an emote set has the emotes at the top level, a user has them in emote_set
+		var user struct {
+			Emotes   []emote `json:"emotes"`
+			EmoteSet struct {
+				Emotes []emote `json:"emotes"`
+			} `json:"emote_set"`
+		}
+		err = json.Unmarshal(data, &user)
+		for _, emote := range append(user.Emotes, user.EmoteSet.Emotes...) {
+			codes = append(codes, emote.Name)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownEmoteFormat, format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s emotes: %w", format, err)
+	}
+	return codes, nil
+}
+
+func isJSONArray(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) > 0 && data[0] == '['
+}
+
+// keeps an EmoteSet in sync with emote exports on disk
+type EmoteRegistry struct {
+	emotes *EmoteSet
+
+	mu      sync.Mutex
+	sources []emoteSource
+}
+
+type emoteSource struct {
+	path   string
+	format EmoteFormat
+}
+
+func NewEmoteRegistry() *EmoteRegistry {
+	return &EmoteRegistry{emotes: NewEmoteSet()}
+}
+
+// the set to put in Config.Emotes, it's updated by every Refresh
+func (r *EmoteRegistry) Emotes() *EmoteSet {
+	return r.emotes
+}
+
+// loads the export at path and adds it to the files read by Refresh
+func (r *EmoteRegistry) AddFile(path string, format EmoteFormat) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sources := append(r.sources[:len(r.sources):len(r.sources)], emoteSource{path, format})
+	if err := r.load(sources); err != nil {
+		return err
+	}
+	r.sources = sources
+	return nil
+}
+
+// reads every file again, e.g. after they were downloaded again.
+// if any of them fails, the emotes are left as they were
+func (r *EmoteRegistry) Refresh() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.load(r.sources)
+}
+
+func (r *EmoteRegistry) load(sources []emoteSource) error {
+	var codes []string
+	for _, source := range sources {
+		file, err := os.Open(source.path)
+		if err != nil {
+			return err
+		}
+		loaded, err := LoadEmotes(file, source.format)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", source.path, err)
+		}
+		codes = append(codes, loaded...)
+	}
+	r.emotes.replace(codes)
+	return nil
+}
@@ -0,0 +1,117 @@
+package buttifier
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLoadEmotes(t *testing.T) {
+	type export struct {
+		format EmoteFormat
+		json   string
+	}
+	resultMap := map[export][]string{
+		{EmoteFormatBTTV, `[{"id":"1","code":"catJAM"},{"id":"2","code":"KEKW"}]`}:                            {"KEKW", "catJAM"},
+		{EmoteFormatBTTV, `{"id":"u","channelEmotes":[{"code":"monkaS"}],"sharedEmotes":[{"code":"Sadge"}]}`}: {"Sadge", "monkaS"},
+		{EmoteFormatFFZ, `{"room":{"set":3},"sets":{"3":{"emoticons":[{"id":1,"name":"OMEGALUL"}]}}}`}:        {"OMEGALUL"},
+		{EmoteFormat7TV, `{"id":"s","name":"set","emotes":[{"id":"1","name":"peepoHappy"}]}`}:                 {"peepoHappy"},
+		{EmoteFormat7TV, `{"id":"u","emote_set":{"emotes":[{"name":"Clap"},{"name":"EZ"}]}}`}:                 {"Clap", "EZ"},
+	}
+	for input, expected := range resultMap {
+		codes, err := LoadEmotes(strings.NewReader(input.json), input.format)
+		if err != nil {
+			t.Errorf("%s %s: %v", input.format, input.json, err)
+			continue
+		}
+		slices.Sort(codes)
+		if !slices.Equal(codes, expected) {
+			t.Errorf("%s %s: expected %v, got %v", input.format, input.json, expected, codes)
+		}
+	}
+
+	if _, err := LoadEmotes(strings.NewReader("{"), EmoteFormatFFZ); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+	if _, err := LoadEmotes(strings.NewReader("[]"), EmoteFormat(42)); !errors.Is(err, ErrUnknownEmoteFormat) {
+		t.Errorf("expected ErrUnknownEmoteFormat, got %v", err)
+	}
+}
+
+func TestEmoteRegistryRefresh(t *testing.T) {
+	dir := t.TempDir()
+	bttv := filepath.Join(dir, "bttv.json")
+	seventv := filepath.Join(dir, "7tv.json")
+	write := func(path string, json string) {
+		if err := os.WriteFile(path, []byte(json), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(bttv, `[{"code":"KEKW"}]`)
+	write(seventv, `{"emotes":[{"name":"Sadge"}]}`)
+
+	registry := NewEmoteRegistry()
+	if err := registry.AddFile(bttv, EmoteFormatBTTV); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.AddFile(seventv, EmoteFormat7TV); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	b.Emotes = registry.Emotes()
+
+	if actual := b.ButtifySentence("KEKW grinding Sadge"); actual != "KEKW buttbutt Sadge" {
+		t.Errorf("expected KEKW buttbutt Sadge, got %s", actual)
+	}
+
+	write(bttv, `[{"code":"catJAM"}]`)
+	if err := registry.Refresh(); err != nil {
+		t.Fatal(err)
+	}
+	if !registry.Emotes().Contains("catJAM") || registry.Emotes().Contains("KEKW") {
+		t.Error("expected Refresh to replace KEKW with catJAM")
+	}
+
+	// a broken file leaves the emotes as they were
+	write(seventv, `{`)
+	if err := registry.Refresh(); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+	if !registry.Emotes().Contains("Sadge") {
+		t.Error("expected a failed Refresh to keep the old emotes")
+	}
+	if err := registry.AddFile(filepath.Join(dir, "missing.json"), EmoteFormatFFZ); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestButtEmote(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	b.Emotes = NewEmoteSet("KEKW")
+	b.ButtEmote = "buttDance"
+
+	result := b.Buttify("KEKW grinding KEKW")
+	if result.Output != "buttDance buttbutt buttDance" {
+		t.Errorf("expected buttDance buttbutt buttDance, got %s", result.Output)
+	}
+	if len(result.Edits) != 4 || result.Edits[0].Original != "KEKW" || result.Edits[0].Replacement != "buttDance" {
+		t.Errorf("expected the emotes to be edits, got %+v", result.Edits)
+	}
+	if result.Undo() != "KEKW grinding KEKW" {
+		t.Errorf("expected undo to restore the emotes, got %s", result.Undo())
+	}
+}
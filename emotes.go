@@ -0,0 +1,89 @@
+package buttifier
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// a set of case-sensitive emote codes, like "KEKW" or "PogChamp".
+// safe for concurrent use
+type EmoteSet struct {
+	mu    sync.RWMutex
+	codes map[string]struct{}
+}
+
+func NewEmoteSet(codes ...string) *EmoteSet {
+	set := &EmoteSet{codes: map[string]struct{}{}}
+	set.Add(codes...)
+	return set
+}
+
+// reads whitespace separated emote codes, usually one per line
+func LoadEmoteSet(r io.Reader) (*EmoteSet, error) {
+	set := NewEmoteSet()
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		set.Add(strings.Fields(scanner.Text())...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// same as LoadEmoteSet, reading from the file at path
+func LoadEmoteSetFile(path string) (*EmoteSet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadEmoteSet(file)
+}
+
+func (e *EmoteSet) Add(codes ...string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, code := range codes {
+		if code != "" {
+			e.codes[code] = struct{}{}
+		}
+	}
+}
+
+func (e *EmoteSet) Remove(codes ...string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, code := range codes {
+		delete(e.codes, code)
+	}
+}
+
+func (e *EmoteSet) Contains(code string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	_, ok := e.codes[code]
+	return ok
+}
+
+func (e *EmoteSet) Len() int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return len(e.codes)
+}
+
+// replaces every code in the set at once, so readers never see a half-loaded set
+func (e *EmoteSet) replace(codes []string) {
+	fresh := map[string]struct{}{}
+	for _, code := range codes {
+		if code != "" {
+			fresh[code] = struct{}{}
+		}
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.codes = fresh
+}
@@ -0,0 +1,29 @@
+package buttifier
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadEmoteSet(t *testing.T) {
+	set, err := LoadEmoteSet(strings.NewReader("KEKW\nPogChamp  Sadge\n\n<3\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Len() != 4 {
+		t.Errorf("expected 4 emotes, got %d", set.Len())
+	}
+	for _, code := range []string{"KEKW", "PogChamp", "Sadge", "<3"} {
+		if !set.Contains(code) {
+			t.Errorf("expected %s to be an emote", code)
+		}
+	}
+	if set.Contains("kekw") {
+		t.Error("expected emote codes to be case-sensitive")
+	}
+
+	set.Remove("KEKW")
+	if set.Contains("KEKW") {
+		t.Error("expected KEKW to be removed")
+	}
+}
@@ -0,0 +1,110 @@
+package buttifier
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// rules for words that are never buttified. excluded words don't count
+// toward ButtificationRate or the MaybeButtify eligibility rules
+type Exclusions struct {
+	// skip the stopwords of the word's language, like "the", "of" or "a"
+	Stopwords bool
+	// skip words with fewer runes
+	MinWordLength int
+	// skip words with fewer syllables
+	MinWordSyllables int
+	// skip numbers like "2024" or "1st"
+	SkipNumbers bool
+	// custom rule, return true to skip word. language is the code of the
+	// patterns the word was hyphenated with
+	Exclude func(word string, language string) bool
+}
+
+// keyed by base language code
+var (
+	stopwordsMu sync.RWMutex
+	stopwords   = map[string]map[string]struct{}{
+		"en": wordSet(
+			"a", "an", "and", "are", "as", "at", "be", "but", "by", "for", "from", "had", "has", "have",
+			"he", "her", "his", "i", "if", "in", "into", "is", "it", "its", "me", "my", "no", "not", "of",
+			"on", "or", "our", "she", "so", "than", "that", "the", "their", "them", "then", "there",
+			"they", "this", "to", "up", "was", "we", "were", "what", "when", "which", "who", "will",
+			"with", "you", "your",
+		),
+		"pt": wordSet(
+			"a", "ao", "aos", "as", "com", "como", "da", "das", "de", "do", "dos", "e", "ela", "ele",
+			"em", "era", "eu", "foi", "isso", "já", "lhe", "mais", "mas", "me", "meu", "na", "nas", "no",
+			"nos", "não", "o", "os", "ou", "para", "pela", "pelo", "por", "que", "se", "sem", "seu", "sua",
+			"são", "também", "te", "tem", "um", "uma", "você", "é",
+		),
+		"es": wordSet(
+			"a", "al", "como", "con", "de", "del", "el", "ella", "en", "era", "es", "esa", "ese", "esta",
+			"este", "fue", "ha", "la", "las", "le", "lo", "los", "me", "mi", "muy", "más", "no", "nos",
+			"o", "para", "pero", "por", "que", "se", "sin", "su", "sus", "te", "tu", "un", "una", "y",
+			"ya", "yo", "él",
+		),
+		"de": wordSet(
+			"aber", "als", "am", "an", "auch", "auf", "aus", "bei", "bin", "bis", "da", "das", "dem",
+			"den", "der", "des", "die", "du", "ein", "eine", "einen", "er", "es", "für", "hat", "ich",
+			"im", "in", "ist", "ja", "mit", "nach", "nicht", "noch", "nur", "oder", "sie", "sind", "so",
+			"und", "uns", "von", "vor", "war", "was", "wie", "wir", "zu", "zum", "zur",
+		),
+	}
+)
+
+func wordSet(words ...string) map[string]struct{} {
+	set := map[string]struct{}{}
+	for _, word := range words {
+		set[strings.ToLower(word)] = struct{}{}
+	}
+	return set
+}
+
+// sets the stopwords Exclusions.Stopwords skips for code, replacing any previous ones
+func RegisterStopwords(code string, words ...string) {
+	stopwordsMu.Lock()
+	defer stopwordsMu.Unlock()
+	stopwords[normalizeLanguageCode(code)] = wordSet(words...)
+}
+
+func isStopword(word string, language string) bool {
+	stopwordsMu.RLock()
+	defer stopwordsMu.RUnlock()
+
+	set, ok := stopwords[language]
+	if !ok {
+		base, _, _ := strings.Cut(language, "-")
+		set = stopwords[base]
+	}
+	_, found := set[strings.ToLower(word)]
+	return found
+}
+
+// "2024" and "1st" are numbers, "frizze5Wade" isn't
+func isNumber(word string) bool {
+	first, _ := utf8.DecodeRuneInString(word)
+	if unicode.IsDigit(first) {
+		return true
+	}
+	return !strings.ContainsFunc(word, unicode.IsLetter)
+}
+
+// whether word, hyphenated into syllables, matches any of the rules
+func (e Exclusions) excludes(word string, language string, syllables int) bool {
+	switch {
+	case e.Stopwords && isStopword(word, language):
+		return true
+	case e.MinWordLength > 0 && utf8.RuneCountInString(word) < e.MinWordLength:
+		return true
+	case e.MinWordSyllables > 0 && syllables < e.MinWordSyllables:
+		return true
+	case e.SkipNumbers && isNumber(word):
+		return true
+	case e.Exclude != nil && e.Exclude(word, language):
+		return true
+	}
+	return false
+}
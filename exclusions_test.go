@@ -0,0 +1,106 @@
+package buttifier
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExclusions(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Exclusions = Exclusions{
+		Stopwords:        true,
+		MinWordLength:    3,
+		MinWordSyllables: 2,
+		SkipNumbers:      true,
+		Exclude: func(word string, language string) bool {
+			return strings.EqualFold(word, "partner")
+		},
+	}
+
+	resultMap := map[string]string{
+		"the":       "the",
+		"The":       "The",
+		"of":        "of",
+		"go":        "go",
+		"cat":       "cat",
+		"2024":      "2024",
+		"1st":       "1st",
+		"Partner":   "Partner",
+		"grinding":  "buttbutt",
+		"something": "buttbutt",
+	}
+	for word, expected := range resultMap {
+		actual, _ := b.ButtifyWord(word)
+		if expected != actual {
+			t.Errorf("expected %s => %s, got %s => %s", word, expected, word, actual)
+		}
+	}
+}
+
+func TestExclusionsRate(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Exclusions.Stopwords = true
+	b.ButtificationRate = 0.5
+	b.Rounding = RoundFloor
+
+	// only the 4 syllables of "grinding something" count, so 2 get replaced
+	result := b.Buttify("the grinding of the something")
+	if len(result.Edits) != 2 {
+		t.Errorf("expected 2 edits, got %+v", result.Edits)
+	}
+	for _, edit := range result.Edits {
+		if edit.Original == "the" || edit.Original == "of" {
+			t.Errorf("expected stopwords to never be replaced, got %+v", edit)
+		}
+	}
+}
+
+func TestExclusionsEligibility(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationProbability = 1
+	b.MinWords = 2
+	b.Exclusions.Stopwords = true
+
+	result := b.MaybeButtify("the grinding")
+	if result.Skipped != SkippedTooFewWords {
+		t.Errorf("expected stopwords to not count toward MinWords, got %s", result.Skipped)
+	}
+}
+
+func TestRegisterStopwords(t *testing.T) {
+	b, err := NewWithLanguage("pt")
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Exclusions.Stopwords = true
+
+	if actual, _ := b.ButtifyWord("para"); actual != "para" {
+		t.Errorf("expected para to be a stopword, got %s", actual)
+	}
+
+	RegisterStopwords("pt-BR", "tipo")
+	defer func() {
+		stopwordsMu.Lock()
+		delete(stopwords, "pt-br")
+		stopwordsMu.Unlock()
+	}()
+	if !isStopword("tipo", "pt-br") || isStopword("para", "pt-br") {
+		t.Error("expected pt-BR stopwords to replace the pt ones")
+	}
+	if !isStopword("para", "pt") {
+		t.Error("expected pt stopwords to be unchanged")
+	}
+}
@@ -0,0 +1,249 @@
+package buttifier
+
+// german patterns: break before a consonant that starts a syllable, keeping ch, ck,
+// sch, ph, th and consonant+l/r onsets together. ch, ck and sch only start a syllable
+// before a vowel, so "Geschichte" is split "Ge-schich-te". schr and schw, and schl,
+// schm and schn at the start of a word, are onsets too, like in "Schwes-ter".
+// pf and tz are split like other clusters, as in "Ap-fel" and "Kat-ze".
+// meant to be used with a Leftmin and Rightmin of 2
+const HyphenatorDataDE = `
+1ba
+1be
+1bi
+1bo
+1bu
+1by
+1bä
+1bö
+1bü
+1ca
+1ce
+1ci
+1co
+1cu
+1cy
+1cä
+1cö
+1cü
+1da
+1de
+1di
+1do
+1du
+1dy
+1dä
+1dö
+1dü
+1fa
+1fe
+1fi
+1fo
+1fu
+1fy
+1fä
+1fö
+1fü
+1ga
+1ge
+1gi
+1go
+1gu
+1gy
+1gä
+1gö
+1gü
+1ha
+1he
+1hi
+1ho
+1hu
+1hy
+1hä
+1hö
+1hü
+1ja
+1je
+1ji
+1jo
+1ju
+1jy
+1jä
+1jö
+1jü
+1ka
+1ke
+1ki
+1ko
+1ku
+1ky
+1kä
+1kö
+1kü
+1la
+1le
+1li
+1lo
+1lu
+1ly
+1lä
+1lö
+1lü
+1ma
+1me
+1mi
+1mo
+1mu
+1my
+1mä
+1mö
+1mü
+1na
+1ne
+1ni
+1no
+1nu
+1ny
+1nä
+1nö
+1nü
+1pa
+1pe
+1pi
+1po
+1pu
+1py
+1pä
+1pö
+1pü
+1qa
+1qe
+1qi
+1qo
+1qu
+1qy
+1qä
+1qö
+1qü
+1ra
+1re
+1ri
+1ro
+1ru
+1ry
+1rä
+1rö
+1rü
+1sa
+1se
+1si
+1so
+1su
+1sy
+1sä
+1sö
+1sü
+1ta
+1te
+1ti
+1to
+1tu
+1ty
+1tä
+1tö
+1tü
+1va
+1ve
+1vi
+1vo
+1vu
+1vy
+1vä
+1vö
+1vü
+1wa
+1we
+1wi
+1wo
+1wu
+1wy
+1wä
+1wö
+1wü
+1xa
+1xe
+1xi
+1xo
+1xu
+1xy
+1xä
+1xö
+1xü
+1za
+1ze
+1zi
+1zo
+1zu
+1zy
+1zä
+1zö
+1zü
+1ßa
+1ße
+1ßi
+1ßo
+1ßu
+1ßy
+1ßä
+1ßö
+1ßü
+c2h
+c2k
+s2c2h
+1cha
+1che
+1chi
+1cho
+1chu
+1chy
+1chä
+1chö
+1chü
+1cka
+1cke
+1cki
+1cko
+1cku
+1cky
+1ckä
+1ckö
+1ckü
+1scha
+1sche
+1schi
+1scho
+1schu
+1schy
+1schä
+1schö
+1schü
+.sch2l
+.sch2m
+.sch2n
+.sch2r
+.sch2w
+1sch2r
+1sch2w
+1p2h
+1t2h
+1q2u
+1b2r
+1d2r
+1f2l
+1f2r
+1g2l
+1g2r
+1k2l
+1k2r
+1p2l
+1p2r
+1t2r`
@@ -0,0 +1,267 @@
+package buttifier
+
+// spanish patterns: break before a consonant that starts a syllable,
+// keeping onset clusters (br, cl, ch, ll, rr, qu...) together
+const HyphenatorDataES = `
+1ba
+1be
+1bi
+1bo
+1bu
+1bá
+1bé
+1bí
+1bó
+1bú
+1bü
+1ca
+1ce
+1ci
+1co
+1cu
+1cá
+1cé
+1cí
+1có
+1cú
+1cü
+1da
+1de
+1di
+1do
+1du
+1dá
+1dé
+1dí
+1dó
+1dú
+1dü
+1fa
+1fe
+1fi
+1fo
+1fu
+1fá
+1fé
+1fí
+1fó
+1fú
+1fü
+1ga
+1ge
+1gi
+1go
+1gu
+1gá
+1gé
+1gí
+1gó
+1gú
+1gü
+1ha
+1he
+1hi
+1ho
+1hu
+1há
+1hé
+1hí
+1hó
+1hú
+1hü
+1ja
+1je
+1ji
+1jo
+1ju
+1já
+1jé
+1jí
+1jó
+1jú
+1jü
+1ka
+1ke
+1ki
+1ko
+1ku
+1ká
+1ké
+1kí
+1kó
+1kú
+1kü
+1la
+1le
+1li
+1lo
+1lu
+1lá
+1lé
+1lí
+1ló
+1lú
+1lü
+1ma
+1me
+1mi
+1mo
+1mu
+1má
+1mé
+1mí
+1mó
+1mú
+1mü
+1na
+1ne
+1ni
+1no
+1nu
+1ná
+1né
+1ní
+1nó
+1nú
+1nü
+1pa
+1pe
+1pi
+1po
+1pu
+1pá
+1pé
+1pí
+1pó
+1pú
+1pü
+1qa
+1qe
+1qi
+1qo
+1qu
+1qá
+1qé
+1qí
+1qó
+1qú
+1qü
+1ra
+1re
+1ri
+1ro
+1ru
+1rá
+1ré
+1rí
+1ró
+1rú
+1rü
+1sa
+1se
+1si
+1so
+1su
+1sá
+1sé
+1sí
+1só
+1sú
+1sü
+1ta
+1te
+1ti
+1to
+1tu
+1tá
+1té
+1tí
+1tó
+1tú
+1tü
+1va
+1ve
+1vi
+1vo
+1vu
+1vá
+1vé
+1ví
+1vó
+1vú
+1vü
+1wa
+1we
+1wi
+1wo
+1wu
+1wá
+1wé
+1wí
+1wó
+1wú
+1wü
+1xa
+1xe
+1xi
+1xo
+1xu
+1xá
+1xé
+1xí
+1xó
+1xú
+1xü
+1ya
+1ye
+1yi
+1yo
+1yu
+1yá
+1yé
+1yí
+1yó
+1yú
+1yü
+1za
+1ze
+1zi
+1zo
+1zu
+1zá
+1zé
+1zí
+1zó
+1zú
+1zü
+1ña
+1ñe
+1ñi
+1ño
+1ñu
+1ñá
+1ñé
+1ñí
+1ñó
+1ñú
+1ñü
+1b2l
+1b2r
+1c2l
+1c2r
+1f2l
+1f2r
+1g2l
+1g2r
+1k2l
+1k2r
+1p2l
+1p2r
+1d2r
+1t2r
+1t2l
+1c2h
+1l2l
+1r2r
+1q2u
+1g2u`
@@ -0,0 +1,232 @@
+package buttifier
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// how ButtifySentence picks the pattern set for the text it gets
+type DetectionMode int
+
+const (
+	// always use the buttifier's current language
+	DetectNone DetectionMode = iota
+	// detect the language once for the whole sentence
+	DetectPerSentence
+	// detect the language of every word separately
+	DetectPerWord
+)
+
+// character n-gram sizes used by the profiles
+const minNgram, maxNgram = 1, 3
+
+const (
+	// how many times every n-gram of languageSamples is counted
+	sampleWeight = 20
+	// how much of each language's n-gram probability comes from its profile, the
+	// rest is the uniform model, so a single rare n-gram doesn't rule a language out
+	profileWeight = 0.9
+	// how likely languages that aren't candidates, and text in none of them, are
+	// compared to a candidate before looking at the text
+	otherLanguagePrior = 0.1
+)
+
+// n-gram and word frequencies for one language
+type languageProfile struct {
+	counts    [maxNgram + 1]map[string]int
+	totals    [maxNgram + 1]int
+	words     map[string]int
+	wordTotal int
+}
+
+// the n-grams and words a profile is built from, see languageNgrams
+type ngramTable struct {
+	totals [maxNgram + 1]int
+	// one "ngram count" per line
+	counts    string
+	wordTotal int
+	// one "word count" per line
+	words string
+}
+
+var (
+	profilesOnce sync.Once
+	profiles     map[string]*languageProfile
+	// number of distinct n-grams and words across every profile, used for smoothing
+	vocabularySizes [maxNgram + 1]int
+	wordVocabulary  int
+)
+
+func loadProfiles() {
+	profiles = map[string]*languageProfile{}
+	vocabulary := [maxNgram + 1]map[string]struct{}{}
+	for n := minNgram; n <= maxNgram; n++ {
+		vocabulary[n] = map[string]struct{}{}
+	}
+	words := map[string]struct{}{}
+
+	for code, table := range languageNgrams {
+		profile := &languageProfile{totals: table.totals, words: map[string]int{}, wordTotal: table.wordTotal}
+		for n := minNgram; n <= maxNgram; n++ {
+			profile.counts[n] = map[string]int{}
+		}
+		for _, line := range strings.Split(strings.TrimSpace(table.counts), "\n") {
+			ngram, count, _ := strings.Cut(line, " ")
+			ngram = strings.ReplaceAll(ngram, "_", " ")
+			n := utf8.RuneCountInString(ngram)
+			profile.counts[n][ngram], _ = strconv.Atoi(count)
+			vocabulary[n][ngram] = struct{}{}
+		}
+		for _, line := range strings.Split(strings.TrimSpace(table.words), "\n") {
+			word, count, _ := strings.Cut(line, " ")
+			profile.words[word], _ = strconv.Atoi(count)
+			words[word] = struct{}{}
+		}
+
+		// chat doesn't read like the corpus, so the samples count for more than their size
+		sample := languageSamples[code]
+		forEachNgram(sample, func(n int, ngram string) {
+			profile.counts[n][ngram] += sampleWeight
+			profile.totals[n] += sampleWeight
+			vocabulary[n][ngram] = struct{}{}
+		})
+		for _, word := range splitWords(sample) {
+			profile.words[word] += sampleWeight
+			profile.wordTotal += sampleWeight
+			words[word] = struct{}{}
+		}
+		profiles[code] = profile
+	}
+
+	for n := minNgram; n <= maxNgram; n++ {
+		vocabularySizes[n] = len(vocabulary[n])
+	}
+	wordVocabulary = len(words)
+}
+
+// the lowercase words of text
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+}
+
+// calls fn with every n-gram of every word in text, words are lowercased
+// and padded with a space on both sides so n-grams can capture prefixes and suffixes
+func forEachNgram(text string, fn func(n int, ngram string)) {
+	for _, word := range splitWords(text) {
+		runes := []rune(" " + word + " ")
+		for n := minNgram; n <= maxNgram; n++ {
+			for i := 0; i+n <= len(runes); i++ {
+				ngram := string(runes[i : i+n])
+				if ngram == " " {
+					continue
+				}
+				fn(n, ngram)
+			}
+		}
+	}
+}
+
+// returns the most likely language of text and how confident the guess is, from 0 to 1.
+// only candidates are considered, or every language with a built-in profile when none are given.
+// the confidence is how likely text is in that language rather than in any other language
+// with a profile, or in none of them: gibberish or a language without a profile gets a low
+// confidence even when there's a single candidate. candidates are expected to show up
+// more often than the other languages, so short messages like "lol" favor them.
+// returns "" and 0 when text has no letters or no candidate has a profile
+func DetectLanguage(text string, candidates ...string) (string, float64) {
+	profilesOnce.Do(loadProfiles)
+
+	if len(candidates) == 0 {
+		for code := range profiles {
+			candidates = append(candidates, code)
+		}
+	}
+
+	var codes []string
+	for _, candidate := range candidates {
+		code := normalizeLanguageCode(candidate)
+		base, _, _ := strings.Cut(code, "-")
+		if _, ok := profiles[base]; ok {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return "", 0
+	}
+
+	// log-likelihood of text for every profile, candidate or not, and for a uniform
+	// model that gives every n-gram the same probability, which stands for "none of them"
+	scores := map[string]float64{}
+	uniform := 0.0
+	seenNgrams := 0
+	forEachNgram(text, func(n int, ngram string) {
+		seenNgrams++
+		uniformProbability := 1 / float64(vocabularySizes[n])
+		uniform += math.Log(uniformProbability) / maxNgram
+		for code, profile := range profiles {
+			// add-one smoothing so unseen n-grams don't zero out a language
+			probability := float64(profile.counts[n][ngram]+1) / float64(profile.totals[n]+vocabularySizes[n])
+			// the n-grams of every size overlap, so each size only counts for a part
+			scores[code] += math.Log(profileWeight*probability+(1-profileWeight)*uniformProbability) / maxNgram
+		}
+	})
+	if seenNgrams == 0 {
+		return "", 0
+	}
+	// whole words tell short messages like "hello" apart better than their n-grams
+	for _, word := range splitWords(text) {
+		uniformProbability := 1 / float64(wordVocabulary)
+		uniform += math.Log(uniformProbability)
+		for code, profile := range profiles {
+			probability := float64(profile.words[word]+1) / float64(profile.wordTotal+wordVocabulary)
+			scores[code] += math.Log(profileWeight*probability + (1-profileWeight)*uniformProbability)
+		}
+	}
+
+	// the posterior of the best candidate, the others get otherLanguagePrior
+	candidateBases := map[string]bool{}
+	best, bestScore := "", math.Inf(-1)
+	for _, code := range codes {
+		base, _, _ := strings.Cut(code, "-")
+		candidateBases[base] = true
+		if score := scores[base]; score > bestScore || (score == bestScore && code < best) {
+			best, bestScore = code, score
+		}
+	}
+	sum := otherLanguagePrior * math.Exp(uniform-bestScore)
+	for code, score := range scores {
+		prior := otherLanguagePrior
+		if candidateBases[code] {
+			prior = 1
+		}
+		sum += prior * math.Exp(score-bestScore)
+	}
+	return best, 1 / sum
+}
+
+// languages loaded into the buttifier
+func (s *session) detectionCandidates() []string {
+	var candidates []string
+	for code := range s.syllabifiers {
+		candidates = append(candidates, code)
+	}
+	return candidates
+}
+
+// picks the language for text among the loaded ones, returning ""
+// when the detector isn't confident enough and text should be left alone.
+// loaded languages without a profile, like ones added with RegisterLanguage,
+// can't be detected, so their text gets a low confidence and is left alone too
+func (s *session) detectLanguage(text string) string {
+	language, confidence := DetectLanguage(text, s.detectionCandidates()...)
+	if language == "" || confidence < s.DetectionThreshold {
+		return ""
+	}
+	return language
+}
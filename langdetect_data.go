@@ -0,0 +1,66 @@
+package buttifier
+
+// chat-like sample text added to the n-gram profiles in langdetect_ngrams.go,
+// keyed by language code. common words and spelling are what matter here,
+// not meaning
+var languageSamples = map[string]string{
+	"en": `the quick brown fox jumps over the lazy dog and then it runs away
+	i think that this is what we should have done with the stream yesterday
+	you are not going to believe what happened when they played the game
+	she would like to know where the other players were before the match
+	thank you for watching and see you all tomorrow night with the new update
+	what is going on here why does nobody ever listen to the people in chat
+	there was something wrong with the sound but everything is working now
+	we have been waiting for this moment for a very long time so let us go
+	he said that they could have won if the team had played a little better
+	which one of these do you want because i really cannot decide right now
+	everyone should follow the rules and be nice to each other in the channel
+	the weather today is bright and sunny although it might rain this evening
+	people who enjoy reading usually find that their writing improves as well
+	hello hi hey chat lol lmao omg gg pog poggers kekw wtf yeah nice thanks guys`,
+	"es": `el rápido zorro marrón salta sobre el perro perezoso y luego se escapa
+	creo que esto es lo que deberíamos haber hecho con el directo de ayer
+	no vas a creer lo que pasó cuando ellos jugaron la partida de anoche
+	ella quiere saber dónde estaban los otros jugadores antes del partido
+	gracias por ver el canal y nos vemos mañana por la noche con la novedad
+	qué está pasando aquí por qué nadie escucha nunca a la gente del chat
+	había algo mal con el sonido pero ahora todo funciona muy bien
+	hemos esperado este momento durante mucho tiempo así que vamos ya
+	él dijo que podrían haber ganado si el equipo hubiera jugado un poco mejor
+	cuál de estos quieres porque de verdad no puedo decidir ahora mismo
+	todos deben seguir las reglas y ser amables los unos con los otros
+	el tiempo hoy está soleado aunque es posible que llueva esta tarde
+	las personas que disfrutan la lectura suelen escribir mucho mejor también
+	muchachos pequeño año niño señor también están hacer tenemos cuando mañana
+	hola buenas jaja jajaja gracias chicos qué tal buenas noches vale oye`,
+	"pt": `a rápida raposa marrom pula sobre o cachorro preguiçoso e depois foge
+	eu acho que isso é o que deveríamos ter feito com a live de ontem
+	você não vai acreditar no que aconteceu quando eles jogaram a partida
+	ela quer saber onde estavam os outros jogadores antes do jogo começar
+	obrigado por assistir e nos vemos amanhã à noite com a novidade
+	o que está acontecendo aqui por que ninguém nunca escuta o pessoal do chat
+	tinha alguma coisa errada com o som mas agora tudo está funcionando bem
+	nós esperamos por este momento durante muito tempo então vamos nessa
+	ele disse que eles poderiam ter ganhado se o time tivesse jogado melhor
+	qual desses você quer porque eu realmente não consigo decidir agora
+	todo mundo deve seguir as regras e ser legal uns com os outros no canal
+	o tempo hoje está ensolarado embora talvez chova no fim da tarde
+	as pessoas que gostam de ler geralmente escrevem muito melhor também
+	não são irmão coração ação informação trabalho filho mulher senhor lhe nhe
+	oi olá kkkk kkkkk valeu galera boa noite obrigado mano né tá blz`,
+	"de": `der schnelle braune fuchs springt über den faulen hund und läuft dann weg
+	ich glaube dass wir das gestern mit dem stream hätten machen sollen
+	du wirst nicht glauben was passiert ist als sie das spiel gespielt haben
+	sie möchte wissen wo die anderen spieler vor dem spiel gewesen sind
+	danke fürs zuschauen und bis morgen abend mit dem neuen update
+	was ist hier los warum hört niemand jemals auf die leute im chat
+	mit dem ton stimmte etwas nicht aber jetzt funktioniert alles wieder
+	wir haben sehr lange auf diesen moment gewartet also lasst uns anfangen
+	er sagte dass sie hätten gewinnen können wenn das team besser gespielt hätte
+	welches davon willst du denn ich kann mich gerade wirklich nicht entscheiden
+	alle sollten sich an die regeln halten und im kanal nett zueinander sein
+	das wetter ist heute schön und sonnig obwohl es am abend regnen könnte
+	menschen die gerne lesen schreiben meistens auch viel besser als andere
+	nicht auch schon noch sich schwierig zwischen straße größer müssen
+	hallo moin servus danke leute na ja genau geil krass tschüss alles gut`,
+}
@@ -0,0 +1,16565 @@
+package buttifier
+
+// the most frequent character n-grams and words of each language, with how often
+// they appear and the total number of n-grams of each size and of words they were
+// counted from. they were counted in the English messages of the gettext catalogs of
+// command-line tools like vim, grep and sed, and in their German, Spanish and
+// Portuguese translations. "_" stands for the space around a word
+var languageNgrams = map[string]ngramTable{
+	"de": {
+		totals: [maxNgram + 1]int{0, 1047895, 1212533, 1047895},
+		counts: `
+e 177656
+n 109577
+i 81995
+t 75588
+r 74576
+s 60287
+a 55029
+d 42155
+l 40227
+u 37488
+h 36541
+g 33868
+o 29219
+c 28704
+m 24342
+b 22458
+f 22038
+k 17037
+z 15911
+p 14649
+w 13696
+v 11167
+ü 10126
+ä 3812
+ö 2569
+y 2507
+x 2115
+ß 1003
+j 994
+q 549
+ø 3
+n_ 41316
+en 40974
+er 39668
+e_ 26875
+t_ 25894
+ch 23185
+ei 21684
+te 20780
+de 20094
+_d 18162
+r_ 17755
+in 15781
+ge 15673
+_a 14866
+ie 13287
+be 13163
+s_ 12852
+_s 11998
+_e 11406
+un 11256
+ic 11173
+st 10924
+es 10325
+re 10167
+nd 9816
+_n 9762
+ng 9628
+le 9207
+an 9176
+ne 8931
+_i 8553
+_w 8454
+_v 8344
+on 8311
+_b 8118
+ti 8042
+at 8029
+is 8009
+_f 7947
+ni 7945
+it 7741
+se 7721
+nt 7681
+_k 7572
+sc 7468
+au 6935
+ht 6926
+ze 6897
+_u 6851
+he 6819
+el 6806
+we 6615
+hl 6533
+rt 6529
+_z 6469
+da 6332
+_g 6275
+rd 6136
+al 6123
+m_ 5831
+di 5796
+ig 5789
+fe 5715
+ve 5613
+d_ 5515
+et 5419
+ar 5395
+nn 5393
+me 5376
+or 5345
+_m 5328
+si 5319
+g_ 4989
+lt 4782
+us 4778
+l_ 4582
+_p 4581
+ss 4551
+h_ 4525
+li 4469
+zu 4417
+eh 4398
+ll 4212
+ke 4098
+_o 3879
+rs 3866
+vo 3843
+fü 3745
+ur 3640
+ta 3583
+_l 3509
+na 3497
+ri 3476
+as 3371
+ab 3351
+io 3335
+tz 3311
+ko 3290
+uf 3232
+mi 3197
+im 3140
+nu 3100
+um 3098
+hr 2999
+ka 2987
+ru 2950
+em 2925
+ra 2918
+wi 2760
+eb 2727
+pa 2689
+_r 2688
+ür 2688
+i_ 2671
+ha 2661
+la 2647
+ma 2633
+ns 2606
+kt 2601
+_h 2529
+am 2518
+eg 2439
+_t 2407
+pr 2327
+il 2306
+ef 2290
+rn 2285
+ut 2207
+od 2205
+mm 2205
+pe 2106
+om 2070
+ak 2056
+fo 2040
+f_ 2028
+op 2019
+gi 2016
+u_ 1971
+ts 1968
+nz 1966
+sp 1941
+tr 1929
+ls 1925
+ro 1921
+_c 1893
+ir 1890
+ol 1875
+rz 1855
+rw 1829
+tu 1813
+ek 1717
+ül 1696
+ck 1690
+ac 1688
+tt 1685
+pt 1680
+hn 1658
+rg 1655
+wa 1651
+fi 1640
+ag 1634
+gü 1628
+gr 1587
+hi 1556
+än 1547
+ga 1511
+du 1510
+nf 1448
+üs 1427
+bi 1420
+ed 1408
+ad 1376
+gu 1348
+fa 1347
+rm 1342
+ex 1326
+ib 1313
+ue 1310
+lü 1296
+zt 1282
+sg 1267
+co 1265
+no 1244
+ff 1234
+eu 1234
+lo 1182
+uc 1181
+rb 1179
+p_ 1171
+ec 1171
+to 1159
+lg 1153
+ba 1146
+gt 1133
+nk 1125
+so 1124
+gs 1100
+rh 1091
+üb 1087
+su 1084
+if 1059
+rf 1042
+rü 1038
+k_ 1031
+_ü 1018
+rl 1014
+gn 1013
+ul 1005
+mp 991
+fu 984
+ah 974
+zi 968
+b_ 961
+po 941
+sa 940
+ug 940
+rc 937
+bl 932
+iv 929
+mo 923
+rk 913
+wu 888
+ob 888
+ea 887
+br 883
+gl 877
+ld 864
+th 863
+bu 844
+os 838
+ee 833
+ot 832
+nb 827
+ub 825
+lu 817
+sy 812
+rr 789
+vi 786
+mu 782
+id 782
+o_ 775
+ft 734
+oc 728
+x_ 723
+üh 721
+pf 721
+ße 720
+up 706
+je 706
+wo 705
+sh 691
+do 672
+dr 661
+tü 658
+za 657
+fr 655
+pp 650
+sw 650
+a_ 643
+ia 641
+y_ 639
+ho 635
+üc 628
+mb 626
+nc 614
+üt 598
+pi 598
+zw 591
+ap 590
+hä 588
+lö 586
+ös 580
+lb 577
+tf 577
+ty 574
+rä 574
+_ä 574
+ip 541
+ok 540
+c_ 539
+_j 537
+z_ 534
+ep 533
+üg 528
+ik 518
+ew 507
+fl 504
+tc 497
+hs 495
+lä 486
+og 485
+xi 481
+bt 480
+yp 478
+äh 477
+tw 466
+ez 459
+sd 458
+rv 455
+ua 430
+pl 428
+iz 425
+_q 422
+är 421
+ku 413
+kl 408
+bo 406
+tl 406
+va 405
+xt 405
+pu 400
+öf 397
+sf 396
+wä 393
+qu 388
+mö 384
+ln 384
+bj 384
+kö 375
+nw 374
+ät 372
+üf 370
+ys 370
+fs 368
+ön 368
+of 364
+bs 363
+sv 363
+oh 361
+ög 345
+tä 340
+ki 336
+fn 333
+v_ 330
+oz 326
+ih 326
+yt 321
+äl 321
+ce 317
+bg 317
+sz 317
+ym 314
+nh 308
+by 305
+nl 300
+ca 300
+af 297
+rp 295
+sk 294
+ow 292
+ai 292
+rö 289
+nv 289
+sl 289
+kg 286
+ou 285
+cr 279
+ks 277
+ci 276
+uß 272
+ry 269
+öß 260
+mt 256
+lf 250
+nü 249
+nö 245
+öt 244
+ms 244
+_ö 240
+ax 239
+fg 238
+hm 227
+ds 223
+yn 221
+tp 220
+ev 220
+ct 219
+eß 217
+bm 215
+nm 214
+dn 211
+ui 208
+td 204
+dl 202
+ps 191
+sn 189
+oo 189
+kz 189
+hu 188
+kr 187
+mg 184
+lp 182
+äg 182
+eo 179
+kn 178
+eä 177
+gb 175
+ix 170
+dp 168
+sb 167
+gk 163
+w_ 162
+lc 162
+ß_ 160
+nä 160
+üp 159
+sm 157
+bb 154
+ör 153
+cl 153
+tn 151
+tg 151
+_x 149
+oß 149
+ml 149
+ph 148
+mü 146
+pg 146
+sr 145
+xp 144
+sä 141
+eö 141
+öc 138
+hö 137
+cs 137
+gp 137
+wü 136
+ün 134
+nr 127
+bh 126
+pk 122
+zl 121
+hb 119
+äs 118
+fä 118
+fz 111
+np 108
+lm 107
+dm 106
+gg 106
+go 105
+ov 105
+tm 100
+q_ 98
+_y 97
+pc 96
+dd 96
+oa 96
+wö 93
+db 93
+av 92
+ja 91
+äu 89
+jo 88
+bf 87
+hw 86
+äd 86
+mä 85
+lz 82
+uk 82
+cd 82
+bz 80
+ey 78
+ud 76
+kd 76
+lv 75
+pd 72
+gä 72
+dv 71
+iu 70
+tb 70
+ii 67
+dt 67
+uz 66
+zä 66
+lw 66
+cc 66
+wn 65
+äc 63
+mw 63
+gh 63
+ws 63
+tv 62
+zö 61
+hf 61
+uw 61
+bn 59
+kü 58
+py 58
+ay 58
+fd 57
+xf 57
+ox 56
+hz 55
+hü 55
+cu 55
+ux 55
+mf 54
+wh 54
+mn 54
+dc 54
+äß 53
+gm 53
+tk 52
+xa 52
+df 51
+vs 51
+zz 50
+ny 50
+dü 48
+üm 47
+zo 47
+ly 47
+dw 46
+öh 46
+cp 45
+xe 45
+hh 44
+az 43
+bw 43
+uh 42
+äf 42
+aa 41
+kb 40
+md 40
+vn 40
+hg 39
+ü_ 39
+qw 39
+kv 38
+mk 37
+hk 36
+oi 36
+zk 34
+dg 33
+eq 33
+ßi 33
+dy 33
+aß 33
+vp 32
+gz 31
+fb 31
+hd 31
+zd 30
+bd 30
+cm 30
+km 30
+fw 29
+kw 29
+aw 28
+hv 28
+kc 28
+mz 28
+xy 28
+yr 28
+wr 28
+xz 27
+lk 27
+ßl 27
+dh 27
+fp 27
+xc 26
+uo 25
+kp 24
+fx 24
+zb 23
+ae 23
+xm 23
+zm 22
+öp 22
+pä 22
+oj 22
+j_ 21
+lr 21
+gc 21
+pm 21
+zv 20
+dx 20
+fc 20
+fy 20
+xx 20
+kf 20
+gv 20
+hp 19
+ya 19
+xu 19
+wd 18
+oe 18
+ßb 18
+fm 17
+gf 17
+kk 17
+fh 17
+wl 17
+sq 17
+bc 16
+hy 16
+lh 16
+iß 16
+jü 16
+en_ 28207
+er_ 12521
+ich 10742
+ein 7838
+_de 7396
+sch 7195
+der 7025
+cht 6752
+den 6213
+ung 6064
+ht_ 5982
+_be 5697
+te_ 5572
+_ni 5566
+nic 5532
+nde 5421
+_au 5234
+ver 5209
+ie_ 5208
+che 5072
+_un 5071
+es_ 4889
+_da 4786
+_di 4722
+in_ 4676
+_ei 4557
+die 4382
+gen 4225
+ben 4217
+_ve 4120
+on_ 4119
+ert 4087
+ch_ 4063
+ier 4053
+zei 4045
+_in 4037
+_we 4022
+ist 3957
+nte 3920
+ate 3896
+rde 3884
+ten 3874
+dat 3749
+ter 3741
+ine 3625
+_vo 3519
+end 3473
+_an 3471
+_ge 3423
+ng_ 3409
+wer 3402
+_zu 3398
+rt_ 3397
+it_ 3367
+ere 3326
+ers 3326
+ste 3315
+tei 3257
+eic 3192
+ion 3176
+st_ 3124
+_si 3116
+nge 3115
+ehl 3086
+feh 3074
+_er 3046
+ren 3019
+nen 2951
+ige 2929
+ent 2845
+_fe 2838
+aus 2828
+ne_ 2792
+_ko 2711
+hen 2676
+_fü 2676
+_is 2640
+erd 2591
+tio 2533
+eit 2529
+sse 2509
+für 2475
+ür_ 2475
+nd_ 2473
+ann 2463
+mit 2426
+le_ 2407
+chl 2386
+ei_ 2311
+nn_ 2300
+sie 2288
+ber 2276
+kan 2270
+und 2258
+von 2257
+auf 2252
+_wi 2251
+bei 2235
+tig 2196
+men 2166
+ges 2089
+kei 2086
+hle 2085
+_ke 2075
+et_ 2069
+des 2065
+rei 2064
+ese 2062
+len 2052
+ebe 2049
+ell 2043
+_sc 2042
+_ze 2017
+im_ 2009
+_re 1980
+_mi 1973
+nnt 1972
+rte 1946
+sta 1929
+_pa 1915
+de_ 1912
+ern 1901
+geb 1901
+abe 1855
+sen 1847
+wen 1836
+erw 1802
+ge_ 1795
+kon 1793
+_ka 1775
+ler 1746
+lti 1738
+ang 1738
+_st 1688
+lle 1687
+hre 1674
+isc 1650
+run 1644
+and 1637
+erz 1630
+gül 1627
+ült 1627
+_al 1623
+_en 1616
+sel 1616
+rd_ 1609
+_se 1599
+zu_ 1582
+lte 1528
+ode 1512
+her 1512
+wir 1510
+rze 1501
+ame 1480
+em_ 1450
+uf_ 1449
+üss 1421
+ind 1402
+lis 1401
+tze 1400
+ird 1374
+eru 1365
+das 1364
+eil 1358
+as_ 1331
+for 1328
+nam 1321
+um_ 1317
+nis 1300
+_na 1289
+ati 1288
+_le 1286
+ngü 1285
+_ar 1284
+lüs 1278
+_op 1277
+ile 1275
+hlü 1271
+ls_ 1266
+_od 1265
+rwe 1261
+ege 1260
+chr 1258
+chn 1258
+eim 1255
+tel 1254
+tzt 1249
+pti 1239
+rst 1239
+_pr 1233
+esc 1232
+ite 1230
+_ab 1228
+vor 1226
+ies 1225
+alt 1221
+nt_ 1213
+ach 1211
+lic 1210
+lt_ 1207
+usg 1192
+ger 1181
+unt 1176
+el_ 1168
+gab 1163
+opt 1159
+onn 1153
+nut 1146
+war 1146
+utz 1138
+_nu 1128
+he_ 1128
+re_ 1115
+enn 1110
+us_ 1106
+all 1100
+fer 1098
+etz 1096
+one 1095
+ner 1091
+ur_ 1083
+ket 1082
+als 1070
+zen 1061
+übe 1053
+hl_ 1050
+se_ 1049
+ass 1048
+enu 1044
+_um 1038
+akt 1031
+ing 1029
+_me 1029
+hal 1026
+me_ 1024
+_üb 1018
+_co 1005
+omm 1004
+tet 1004
+_gi 998
+art 996
+ort 993
+hni 991
+tie 989
+set 981
+eig 976
+änd 976
+spe 963
+gt_ 962
+nst 961
+anz 960
+age 952
+_ak 948
+is_ 945
+efe 943
+fun 938
+zt_ 934
+lge 932
+ens 932
+geg 927
+mme 922
+ene 919
+wei 917
+ien 911
+uch 909
+_im 909
+be_ 907
+gef 907
+git 904
+tte 902
+kom 897
+_ha 894
+pro 889
+ign 884
+orm 882
+at_ 882
+int 874
+rie 871
+wur 860
+its 860
+_wu 859
+mat 858
+ete 858
+mer 857
+urd 849
+les 841
+ngs 838
+rsc 833
+_bi 823
+erh 820
+lie 818
+nze 812
+_fo 811
+tes 809
+ess 802
+_so 798
+ser 795
+ins 784
+gel 782
+_sp 774
+erf 773
+det 770
+est 765
+al_ 765
+erl 765
+ekt 762
+fen 754
+rma 753
+sge 749
+ume 749
+nac 748
+ts_ 748
+zer 741
+ake 740
+rch 734
+_li 731
+_ma 728
+rbe 722
+eib 718
+lag 711
+ahl 709
+_wa 708
+ech 707
+ühr 706
+ss_ 704
+pak 703
+kti 702
+füh 702
+_gr 699
+nne 698
+ll_ 698
+_ne 697
+ali 697
+rha 696
+ele 693
+ig_ 685
+itt 685
+_sy 683
+eie 683
+lau 680
+sig 675
+dar 675
+tat 669
+arg 669
+sti 668
+era 666
+err 664
+ktu 661
+_ex 660
+_hi 660
+oll 657
+rn_ 655
+zah 646
+_es 644
+chi 643
+or_ 643
+ede 639
+erg 638
+com 633
+rgu 627
+gum 626
+sio 625
+_no 623
+dem 621
+tan 619
+nur 617
+sei 615
+bef 615
+rne 613
+isi 611
+kt_ 610
+neu 606
+hla 605
+rsi 604
+ord 601
+ück 599
+eld 597
+zum 596
+rti 596
+ini 595
+ütz 595
+res 592
+wie 590
+an_ 588
+rüc 587
+_ta 586
+ori 583
+lei 583
+sin 579
+ran 578
+_mu 574
+uel 574
+stü 573
+mmi 573
+arb 571
+zie 570
+erk 569
+rge 569
+nun 564
+nbe 563
+ale 562
+wor 560
+pei 559
+iel 559
+iti 557
+nal 556
+ibe 555
+_te 555
+atu 555
+mus 552
+tiv 552
+pas 550
+mod 542
+bek 541
+lös 540
+dun 539
+hlg 539
+tüt 537
+erb 536
+_fa 536
+tem 534
+urc 529
+_su 527
+rec 527
+ifi 526
+ons 523
+enz 521
+lun 520
+nda 518
+fol 517
+nfo 515
+str 513
+tor 511
+ard 510
+amm 510
+fal 508
+tra 507
+egi 507
+hte 507
+rwa 506
+uss 504
+rla 504
+ina 503
+unb 503
+hne 502
+onf 502
+olg 501
+_än 501
+zur 499
+ntf 498
+elt 496
+füg 496
+tfe 495
+gli 494
+spr 492
+ken 492
+eka 492
+inf 489
+tre 487
+hin 487
+chs 485
+pri 483
+eme 482
+llt 480
+nga 479
+sga 479
+efu 479
+omp 477
+nie 477
+_he 477
+ck_ 476
+ble 476
+eue 475
+bes 474
+ruf 472
+bar 471
+ar_ 471
+odu 469
+äng 469
+_ob 464
+typ 461
+vie 461
+eis 460
+_du 457
+sic 456
+pat 456
+suc 455
+per 455
+ppe 448
+nor 447
+nzu 443
+igt 443
+cke 442
+erv 439
+reg 437
+_br 436
+dur 433
+aub 433
+han 431
+ehe 431
+mal 431
+tch 430
+att 429
+ric 429
+sam 428
+cha 427
+dig 426
+rat 426
+ffe 425
+hri 425
+prü 425
+igu 422
+_lo 420
+_la 419
+nat 417
+ade 416
+zus 413
+ehr 412
+ad_ 412
+bin 411
+gna 410
+leg 409
+zug 409
+bt_ 408
+bit 408
+tim 406
+eer 404
+_vi 404
+ndu 402
+num 399
+net 399
+rag 397
+öff 397
+tri 396
+ext 396
+lee 395
+ide 394
+tur 394
+aut 393
+_tr 389
+jek 389
+ösc 388
+ast 387
+bra 387
+tas 386
+tal 383
+ref 382
+bje 380
+exi 379
+fin 378
+ual 378
+rea 376
+lin 376
+rfo 376
+tun 376
+och 373
+pfa 372
+ndi 371
+gru 371
+obj 371
+rna 370
+nes 369
+meh 369
+rer 369
+rüf 369
+atc 369
+are 367
+_kö 367
+nem 365
+unk 364
+kön 363
+önn 363
+zwi 362
+tar 362
+nkt 361
+ied 361
+_mo 360
+hei 360
+_fi 356
+ssw 353
+tua 353
+nch 353
+umm 352
+gno 352
+hie 349
+ive 349
+ntr 349
+tif 348
+ika 347
+rnu 347
+nwe 347
+iff 346
+wäh 346
+uge 345
+_mö 345
+ett 344
+ari 344
+arc 343
+kat 343
+am_ 343
+rse 343
+pe_ 343
+kte 343
+zte 342
+ruc 341
+yp_ 341
+bun 341
+nth 340
+eri 340
+zeu 338
+eug 338
+_gü 337
+fig 336
+erm 335
+arn 333
+gew 333
+ns_ 332
+hat 331
+inz 331
+xis 330
+sit 329
+gis 327
+_zw 326
+gra 326
+abl 326
+ram 325
+lls 325
+_ig 325
+min 324
+lem 324
+swo 324
+nfi 324
+sys 323
+geh 323
+upp 322
+lli 321
+mög 321
+ögl 321
+hlt 321
+rve 321
+ag_ 320
+fne 320
+por 320
+ufe 317
+ft_ 317
+ffn 317
+mma 316
+ont 315
+eln 315
+pos 314
+ieb 314
+alb 314
+rin 314
+nit 313
+anc 313
+oze 312
+yst 312
+bge 310
+ack 310
+ust 310
+tab 309
+lat 309
+sol 308
+man 306
+par 305
+fad 305
+fik 304
+mel 304
+_je 304
+lsc 301
+tag 301
+rit 300
+mpo 300
+äre 299
+_qu 299
+nza 297
+rup 297
+gur 297
+abg 294
+_n_ 294
+_po 293
+id_ 293
+yte 292
+imm 292
+zun 292
+sh_ 292
+gin 291
+byt 290
+lb_ 290
+_zi 290
+beg 289
+ßer 289
+met 289
+ex_ 289
+zes 288
+bee 286
+_lö 286
+usf 285
+que 285
+emp 284
+hes 284
+hr_ 283
+hli 283
+tis 283
+_oh 283
+ndo 283
+_ih 282
+wis 280
+ähl 280
+_ad 280
+sym 278
+ufr 278
+ohn 278
+_pf 278
+llu 277
+roz 277
+sfü 277
+ore 276
+inn 275
+ält 274
+ope 274
+rek 273
+nke 273
+een 272
+ink 272
+rre 272
+tue 272
+osi 270
+org 270
+ssi 268
+tex 266
+tsc 266
+iss 266
+sve 265
+izi 265
+rau 264
+fru 264
+ln_ 263
+om_ 262
+eut 262
+grö 261
+orh 261
+rs_ 260
+rig 260
+röß 259
+lan 258
+ubt 257
+rem 257
+ote 257
+let 256
+enk 256
+üge 256
+uße 255
+ena 255
+nta 254
+öße 254
+mbo 254
+_ho 254
+_do 254
+_za 253
+uck 253
+_sh 253
+ry_ 253
+gle 252
+dru 252
+ymb 251
+auß 251
+var 251
+ses 251
+con 251
+usa 251
+fel 251
+_by 250
+tt_ 250
+_ty 250
+bol 250
+ria 250
+nts 249
+fli 249
+jed 249
+dex 249
+rsp 248
+ela 248
+uer 248
+ead 247
+ute 247
+enb 247
+imi 246
+pal 246
+hän 246
+fra 246
+auc 245
+nti 245
+gem 244
+pre 243
+tzu 242
+nöt 241
+öti 241
+pel 241
+pie 241
+iv_ 240
+ihr 240
+erp 239
+ogr 239
+bel 239
+lde 239
+gun 239
+hab 239
+etr 238
+ivi 238
+urü 237
+häl 237
+_ba 237
+rli 236
+rhe 236
+kop 236
+pac 236
+dus 235
+anw 235
+il_ 235
+_öf 235
+mie 233
+ve_ 233
+rif 233
+zuf 233
+ima 232
+oni 232
+pen 232
+ezi 231
+bre 230
+eta 230
+tli 229
+ufg 228
+iab 227
+sub 226
+_wo 226
+enö 226
+hel 226
+tsp 224
+_fu 224
+dul 224
+dir 224
+uff 223
+_bl 223
+not 223
+gri 221
+rsu 221
+efü 221
+usd 221
+lad 220
+ld_ 220
+ulä 219
+uto 219
+ut_ 219
+emo 219
+edi 219
+hiv 219
+_va 218
+nsp 218
+ibu 218
+kal 218
+ieß 217
+une 217
+rnt 217
+fil 216
+fe_ 216
+ße_ 215
+sda 215
+els 215
+las 215
+ura 215
+ash 215
+twe 214
+aft 214
+spa 213
+eng 213
+ock 213
+sdr 213
+tsv 213
+ßen 212
+inh 212
+itu 212
+ise 211
+rpr 211
+_us 211
+tro 209
+ce_ 208
+rog 208
+get 207
+pt_ 207
+_gl 206
+rmi 206
+ewe 206
+anf 206
+thä 205
+oka 205
+sst 204
+_pi 204
+gs_ 204
+ff_ 204
+gan 203
+xt_ 203
+haf 203
+def 202
+_ch 202
+ema 202
+lok 202
+eda 201
+noc 201
+hrt 201
+ibt 201
+she 200
+ull 199
+ze_ 199
+sze 199
+rda 198
+_kl 198
+nnu 198
+nk_ 197
+ial 197
+_or 197
+tst 197
+gre 196
+elb 195
+dre 195
+syn 194
+uen 194
+blo 194
+rbi 194
+abs 193
+ito 193
+ara 193
+ufü 193
+efi 192
+umb 192
+_bu 192
+rfü 192
+nsc 191
+ckg 190
+gib 190
+log 190
+epo 190
+nul 189
+del 187
+esp 187
+rep 187
+_ap 187
+ubm 187
+kun 186
+use 186
+vol 186
+hol 186
+nha 185
+zwe 185
+_wä 185
+bmo 185
+tus 184
+sun 184
+mpr 183
+hil 183
+the 183
+_ur 183
+ke_ 182
+bed 182
+bea 182
+bis 182
+ue_ 182
+fge 182
+_el 181
+usw 181
+ufl 181
+stä 180
+sis 179
+egt 179
+ato 178
+loc 178
+eße 178
+kze 178
+bas 178
+erä 177
+geä 177
+eän 177
+ed_ 177
+nz_ 177
+os_ 177
+mot 177
+_pu 176
+ban 175
+tän 175
+win 174
+elö 174
+umg 174
+_fr 173
+ire 173
+län 172
+_ti 172
+rke 171
+beh 171
+mge 171
+enf 171
+adr 171
+ole 171
+ank 170
+eha 168
+_am 168
+oli 167
+bet 167
+los 167
+_em 166
+rl_ 166
+ase 166
+sof 165
+gba 165
+sor 164
+urs 164
+nba 163
+imp 163
+mei 162
+_ga 162
+twa 162
+_lä 162
+fiz 162
+fes 162
+lst 162
+dif 161
+eku 161
+gke 161
+ahr 161
+opp 161
+inc 161
+cod 160
+fte 160
+igk 160
+ügb 160
+nfa 160
+ieh 160
+rar 159
+vom 159
+zif 159
+akz 159
+sem 159
+gro 159
+eba 159
+cks 158
+eck 158
+nüp 158
+nve 157
+rip 157
+ans 157
+oft 157
+räg 157
+rga 156
+kur 156
+lfe 156
+gte 156
+eze 156
+nsa 156
+ror 156
+rkn 156
+üpf 156
+ris 155
+atz 155
+ul_ 155
+tia 155
+knü 155
+zul 154
+ars 154
+wan 154
+odi 153
+ebu 153
+tok 153
+lta 152
+ona 152
+gst 152
+mar 152
+rot 152
+mt_ 151
+ug_ 151
+_rü 151
+bau 151
+eid 150
+ugt 150
+ckt 150
+wid 150
+deu 150
+hst 149
+ugr 149
+hs_ 149
+ngl 149
+mbe 149
+kla 149
+tät 148
+bli 148
+ark 148
+hau 148
+lda 148
+nan 147
+egu 147
+roß 147
+cip 147
+nfl 146
+ilf 146
+ngi 146
+ail 146
+dis 146
+ipa 146
+vim 146
+ilt 145
+_ro 145
+nci 145
+_mü 144
+ix_ 144
+ipt 143
+rän 143
+üfe 143
+ap_ 143
+_at 143
+chu 142
+rm_ 141
+efo 141
+ora 141
+ime 141
+hea 141
+geö 141
+eöf 141
+rro 141
+egr 140
+hme 140
+gig 140
+ant 140
+pon 140
+pfu 140
+rim 139
+pru 138
+opi 138
+ob_ 138
+ule 138
+arf 137
+eli 137
+ult 137
+pez 137
+do_ 137
+pun 136
+bez 136
+rob 136
+müs 135
+ors 135
+ffs 135
+tai 135
+ild 135
+upt 135
+exp 135
+mpl 134
+tha 134
+kie 133
+drü 133
+mpf 133
+ili 133
+hls 133
+rib 133
+sek 132
+ntw 132
+fan 132
+ral 131
+ät_ 131
+bil 131
+uri 131
+wec 130
+aup 130
+_dr 130
+eti 130
+tz_ 129
+itä 129
+dau 129
+aue 129
+rdn 129
+but 129
+dop 129
+oto 129
+lär 128
+ftw 128
+ldu 127
+sto 127
+rfa 127
+max 126
+rzw 126
+gni 126
+_cr 126
+hem 126
+eak 125
+hse 125
+eam 125
+trä 125
+ttr 125
+ms_ 124
+lch 124
+mac 124
+ma_ 123
+eko 123
+mm_ 123
+rol 123
+ähr 123
+rfe 123
+rf_ 122
+oma 122
+reb 122
+ndl 121
+_b_ 121
+ube 121
+_up 121
+nig 121
+_id 121
+äge 121
+rru 121
+liz 120
+abh 120
+ory 120
+top 120
+oko 120
+rac 119
+dow 119
+sla 119
+app 118
+nsi 118
+da_ 118
+_sa 118
+ks_ 117
+ieg 117
+chb 117
+ure 117
+lbe 117
+pla 117
+kol 117
+nfü 117
+gul 116
+abb 116
+ebr 116
+off 116
+lik 116
+uns 116
+ntl 116
+ear 116
+_ki 115
+tom 115
+hts 115
+eal 115
+big 114
+tzl 114
+zli 114
+kri 114
+ot_ 114
+bhä 114
+pli 114
+mmt 114
+när 114
+_ca 114
+rki 114
+unv 114
+äss 113
+dea 113
+out 113
+hec 113
+nme 113
+_ru 113
+_to 113
+deb 113
+läs 112
+obl 112
+ab_ 112
+itz 112
+inä 112
+no_ 112
+nsn 111
+ick 111
+swe 111
+ept 111
+buf 111
+wür 110
+ums 110
+elu 110
+_pl 110
+son 110
+lob 110
+dei 109
+_fl 109
+ags 109
+uft 109
+ize 109
+rce 109
+ple 109
+nvo 109
+uti 108
+tin 108
+wah 108
+url 108
+ost 108
+oss 108
+ürd 107
+ker 107
+uth 107
+rts 107
+anm 107
+rtr 107
+tho 107
+ynt 106
+sat 106
+ätz 106
+mas 106
+mul 106
+tna 106
+un_ 106
+ty_ 106
+tax 105
+urz 105
+bew 105
+irk 105
+sät 104
+nau 104
+ero 104
+ol_ 104
+kor 104
+nli 104
+ikt 104
+rzu 104
+hlo 104
+has 104
+orä 104
+rär 104
+dit 104
+ill 103
+rom 103
+öch 103
+obe 103
+gep 103
+rka 103
+elp 103
+his 103
+buc 102
+üfu 102
+ain 102
+fo_ 102
+hlu 102
+_z_ 102
+esi 102
+wel 102
+our 102
+ven 102
+cti 102
+lon 102
+bru 101
+nle 101
+agi 101
+axi 100
+xim 100
+_ri 100
+nko 100
+kle 100
+gsd 100
+bbr 100
+mon 100
+fah 100
+spi 100
+oß_ 100
+bst 99
+ört 99
+_e_ 99
+xte 99
+sou 99
+rme 98
+eve 98
+was 98
+ret 98
+ngt 98
+_d_ 98
+rus 98
+ush 98
+map 98
+lus 97
+dne 97
+wed 97
+scr 97
+ian 96
+usä 96
+_of 96
+hru 96
+elc 96
+fre 96
+gsz 96
+val 95
+sso 95
+so_ 95
+zel 95
+ues 95
+uni 95
+bsc 95
+weg 94
+rak 94
+hit 94
+teh 93
+gss 93
+eff 93
+_th 93
+asc 93
+skr 93
+chä 93
+opf 93
+lig 93
+zep 93
+ani 93
+emb 93
+pst 93
+rät 93
+hti 92
+dek 92
+_cl 92
+_ku 91
+lug 91
+ula 91
+usz 91
+hrä 91
+rad 91
+og_ 91
+tta 91
+ip_ 90
+epa 90
+roc 90
+inw 90
+ewä 90
+kge 90
+pid 90
+esa 89
+nse 89
+sna 89
+rab 89
+stl 89
+orr 89
+eni 89
+ügt 89
+fac 89
+_eb 89
+_s_ 89
+_ss 89
+ibl 88
+dan 88
+tge 88
+eu_ 88
+esk 88
+_as 88
+pkg 88
+ib_ 87
+ds_ 87
+gsv 87
+bro 87
+nsd 87
+ak_ 87
+tru 87
+gge 87
+ffi 86
+ogi 86
+kin 86
+ype 86
+_dp 86
+tum 86
+pts 86
+egl 86
+_a_ 86
+non 85
+ros 85
+_ja 84
+heb 84
+tde 84
+hun 84
+_gp 84
+ow_ 84
+ief 83
+kod 83
+häd 83
+sum 83
+rkl 83
+apt 83
+fze 83
+th_ 83
+mag 83
+to_ 82
+har 82
+ädi 82
+neh 82
+rdi 82
+ome 82
+ets 82
+ebi 82
+_ed 82
+fas 81
+eif 81
+til 81
+mpa 81
+ehm 81
+ree 81
+hon 81
+kg_ 81
+ssc 81
+ape 81
+gla 81
+usl 81
+ata 80
+sre 80
+otw 80
+med 80
+din 80
+hba 80
+rel 80
+dpk 80
+pin 80
+fix 79
+tib 79
+ats 79
+_pe 79
+oti 79
+san 79
+_jo 79
+ict 79
+tic 78
+tle 78
+nzi 78
+ec_ 78
+uts 78
+ded 78
+alm 78
+dia 77
+rev 77
+mai 77
+ron 77
+szu 77
+op_ 77
+nna 77
+ect 77
+gez 77
+ubi 77
+ax_ 76
+chw 76
+geo 76
+ose 76
+_gn 76
+add 76
+teu 76
+loa 76
+env 76
+au_ 76
+sts 76
+_y_ 76
+sty 75
+inu 75
+eth 75
+nhä 75
+nar 75
+ync 75
+soc 75
+lar 75
+anh 74
+lit 74
+kga 74
+bib 74
+gnu 74
+rgl 74
+llo 74
+job 74
+wör 73
+amt 73
+rvi 73
+oth 73
+usc 73
+igi 73
+enü 73
+pus 73
+hnu 72
+lla 72
+ssu 72
+swa 72
+tek 72
+htl 72
+sha 72
+_q_ 72
+nei 71
+mmu 71
+mun 71
+flö 71
+hod 71
+_ht 71
+isa 71
+ta_ 70
+smo 70
+fla 70
+nma 70
+gie 70
+gän 70
+ic_ 70
+ee_ 70
+bev 70
+_ok 70
+rho 70
+_c_ 70
+thr 69
+_wü 69
+teg 69
+sva 69
+mak 69
+aum 69
+ps_ 69
+isy 69
+cac 69
+rg_ 69
+two 69
+rba 69
+ice 68
+ads 68
+_ra 68
+_ph 68
+üft 68
+inm 68
+kli 68
+rtu 68
+ntu 68
+key 68
+lse 68
+_gs 68
+stu 67
+ami 67
+üll 67
+lio 67
+iot 67
+anl 67
+lve 67
+tp_ 67
+ct_ 67
+_cd 67
+abi 67
+igg 67
+lm_ 67
+mbr 66
+ipe 66
+rrt 66
+zäh 66
+siv 66
+mbi 66
+äte 66
+evo 66
+enp 66
+dli 66
+_ac 66
+asi 66
+cri 66
+cop 66
+dic 66
+ntt 66
+sbe 65
+hek 65
+tts 65
+ewa 65
+omb 65
+kar 65
+fie 65
+nel 65
+nnz 65
+_fs 65
+hro 65
+ted 65
+dos 65
+sas 65
+ith 64
+dez 64
+rop 64
+ewi 64
+cal 64
+dlu 64
+ro_ 64
+sau 64
+ove 64
+pan 64
+unz 64
+low 64
+mbl 64
+sl_ 64
+bla 63
+äch 63
+ana 63
+olt 63
+zit 63
+kto 63
+tpa 63
+ala 63
+pec 63
+ssl 63
+rc_ 62
+rdr 62
+cho 62
+sfe 62
+pip 62
+möc 62
+agt 62
+abu 62
+own 62
+mis 62
+sp_ 62
+nfr 62
+crl 62
+onv 61
+tit 61
+oot 61
+gek 61
+irm 61
+abf 61
+up_ 61
+oad 61
+ary 61
+pg_ 61
+ok_ 61
+fek 61
+gpg 61
+pto 61
+_bo 61
+rsa 60
+nks 60
+lp_ 60
+roo 60
+ttd 60
+bac 60
+dsc 60
+efr 60
+änk 60
+ufz 60
+tau 60
+ean 60
+zim 59
+ems 59
+rkt 59
+hör 59
+zuw 59
+seh 59
+ace 59
+ws_ 59
+ntp 59
+fse 59
+ook 59
+klo 59
+fül 58
+lgr 58
+tad 58
+ir_ 58
+ups 58
+ath 58
+mwa 58
+_gu 58
+ek_ 58
+sec 58
+rty 58
+dle 57
+red 57
+ohl 57
+äuf 57
+dmi 57
+ium 57
+öse 57
+ada 57
+ehö 57
+htt 57
+ttp 57
+bal 57
+hos 57
+isu 57
+ows 57
+lgt 56
+ork 56
+ils 56
+fis 56
+sko 56
+adm 56
+lsz 56
+tda 56
+_ec 56
+öst 56
+olu 56
+qui 56
+ift 56
+rap 56
+xpo 56
+eht 56
+_ic 56
+etc 56
+xtr 56
+hex 56
+ktw 56
+thm 55
+tsa 55
+uid 55
+dri 55
+swä 55
+lia 55
+rk_ 55
+efa 55
+nu_ 55
+eco 55
+eno 55
+fsu 55
+üng 55
+enl 54
+rbr 54
+inb 54
+tzw 54
+cli 54
+orz 54
+kta 54
+umw 54
+ux_ 54
+cor 54
+sba 53
+_sk 53
+bte 53
+bfr 53
+_ui 53
+bul 53
+chü 53
+ufs 53
+obs 53
+pft 53
+mpe 53
+üfs 53
+tty 53
+fet 53
+pul 53
+pho 53
+rry 53
+mäß 52
+läu 52
+ita 52
+eor 52
+ias 52
+chf 52
+rgä 52
+chz 52
+_et 52
+mte 52
+ml_ 52
+dpr 52
+edo 52
+uwe 52
+xfe 52
+nux 52
+_ub 52
+ubu 52
+tu_ 52
+nsw 51
+ife 51
+mes 51
+lne 51
+_sl 51
+doc 51
+cd_ 51
+axf 51
+uga 51
+ahi 50
+tve 50
+snu 50
+nto 50
+riv 50
+tma 50
+hom 50
+raf 50
+std 50
+dec 50
+nlo 50
+rku 50
+puf 50
+whi 50
+gp_ 50
+tiz 50
+api 50
+sow 49
+hwe 49
+zip 49
+_hö 49
+hub 49
+chm 49
+lbs 49
+ano 49
+sca 49
+_x_ 49
+oba 49
+isp 49
+ndp 49
+_wh 49
+bri 49
+vs_ 49
+itm 49
+rbu 48
+gor 48
+nim 48
+gsf 48
+upd 48
+cs_ 48
+prä 48
+tap 48
+rz_ 48
+msc 48
+tip 48
+flo 48
+dd_ 48
+dvo 48
+_oc 48
+_dv 48
+hmu 47
+tüm 47
+fsr 47
+_dü 47
+rta 47
+pda 47
+bni 47
+gar 47
+tos 47
+_kd 47
+rri 47
+uml 47
+rhi 47
+ssa 47
+ub_ 47
+llb 47
+cko 47
+rah 46
+ntü 46
+üme 46
+nhe 46
+bug 46
+ndb 46
+lam 46
+rgr 46
+anu 46
+pfz 46
+inr 46
+htu 46
+ega 46
+rün 46
+fäl 46
+löc 45
+gat 45
+eke 45
+diu 45
+orb 45
+rle 45
+ebn 45
+cro 45
+bs_ 45
+rvo 45
+bia 45
+mle 45
+tti 45
+neg 45
+cap 45
+cur 45
+dap 45
+alg 44
+gsa 44
+esb 44
+itg 44
+dür 44
+ürf 44
+lpu 44
+zuz 44
+skt 44
+nre 44
+llg 44
+ii_ 44
+mp_ 44
+eih 44
+gsp 44
+ri_ 44
+elf 44
+sup 44
+iva 44
+ti_ 44
+pgp 44
+_ld 44
+ocs 44
+äts 43
+nzz 43
+oke 43
+lor 43
+bzu 43
+cen 43
+ico 43
+pps 43
+far 43
+aph 43
+wal 43
+glo 43
+inl 43
+dep 43
+mov 43
+sco 43
+lba 43
+ond 43
+trg 43`,
+		wordTotal: 164638,
+		words: `
+nicht 5411
+der 3445
+die 3058
+ist 2588
+für 2461
+werden 2402
+von 2236
+in 2207
+mit 1647
+kann 1629
+sie 1587
+des 1567
+zu 1524
+und 1428
+wird 1374
+datei 1340
+oder 1243
+beim 1176
+das 1171
+auf 1123
+konnte 1082
+ein 1056
+fehler 1032
+keine 969
+den 968
+eine 901
+git 733
+kein 727
+als 716
+im 701
+wurde 679
+nur 615
+schlüssel 588
+zum 587
+es 587
+aus 573
+option 553
+um 551
+wenn 536
+nach 531
+ungültige 518
+fehlgeschlagen 500
+dem 483
+dateien 479
+gefunden 467
+sind 450
+anzeigen 447
+an 445
+angegeben 415
+ungültiger 414
+zeile 412
+lesen 400
+commit 399
+optionen 398
+sein 387
+verzeichnis 382
+einen 381
+unterstützt 381
+bei 381
+ausgeben 376
+argument 364
+zeichen 358
+verwenden 350
+verwendet 349
+alle 346
+wie 345
+wert 339
+muss 335
+einer 322
+diese 321
+einem 318
+durch 315
+aber 315
+hat 315
+name 298
+können 297
+n 294
+schreiben 289
+warnung 289
+befehl 282
+patch 280
+version 278
+ohne 278
+benutzen 273
+liste 269
+bitte 269
+paket 264
+setzen 253
+eines 252
+zur 248
+existiert 241
+anzahl 238
+bereits 238
+entfernen 238
+passwort 229
+diesen 221
+aufruf 218
+zeilen 218
+änderungen 215
+zahl 212
+erwartet 211
+index 211
+ausgabe 210
+benutzer 209
+ungültiges 208
+gesetzt 207
+enthält 205
+erlaubt 205
+branch 205
+dieser 204
+löschen 200
+möglich 195
+fehlt 191
+ändern 189
+argumente 187
+ausführen 186
+außerhalb 186
+benötigt 185
+entfernt 184
+typ 183
+standard 182
+haben 180
+auch 178
+arbeitsverzeichnis 176
+noch 174
+daten 172
+erstellen 172
+remote 169
+merge 169
+objekt 167
+vor 165
+format 165
+erzeugen 164
+mehr 162
+vom 159
+unbekannter 159
+ignoriert 158
+modus 157
+namen 157
+pakete 156
+gruppe 156
+gibt 156
+dies 152
+sich 152
+am 150
+server 149
+informationen 148
+statt 148
+geändert 147
+wurden 147
+folgenden 146
+pfad 145
+öffnen 144
+geben 143
+neue 141
+ausdruck 140
+beenden 139
+bytes 137
+keinen 137
+zeichenkette 137
+hinzufügen 137
+geöffnet 137
+müssen 134
+falsche 134
+geschrieben 133
+unbekannte 133
+error 133
+tag 130
+gelesen 129
+größe 129
+eingabe 129
+über 129
+erforderlich 129
+null 128
+status 128
+shell 128
+bereichs 127
+signatur 126
+ungültig 125
+benutzt 124
+internal 124
+finden 123
+anderen 123
+aktuellen 123
+user 123
+muster 123
+b 121
+dieses 121
+ziel 121
+vorhanden 120
+dass 119
+principal 119
+verfügbar 118
+dateinamen 116
+viele 115
+erzeugt 115
+gelöscht 115
+gültigen 114
+angegebenen 113
+archiv 113
+falls 112
+speicher 112
+ende 112
+installiert 112
+enthalten 112
+feld 112
+commits 112
+submodul 111
+darf 109
+vim 108
+während 107
+software 107
+mehrere 106
+head 106
+zertifikat 106
+da 105
+neuen 104
+z 102
+datenbank 102
+groß 100
+rebase 100
+e 99
+bis 99
+funktion 99
+angeben 99
+zeit 98
+system 98
+d 98
+auflisten 98
+erhalten 97
+byte 97
+repository 97
+objekte 96
+leerzeichen 95
+erstellt 95
+dateiname 94
+diff 94
+zwischen 93
+übersprungen 93
+referenz 92
+aktualisieren 91
+befehle 91
+beendet 91
+werte 90
+hilfe 90
+signal 90
+adresse 90
+s 89
+register 89
+überschreiben 88
+leer 88
+vorgabe 88
+zurück 88
+anwenden 88
+angegebene 87
+diesem 87
+programm 86
+neu 86
+quelle 86
+a 86
+standardeingabe 85
+soll 85
+verbindung 85
+buffer 85
+innerhalb 83
+bereich 82
+ignorieren 81
+versuchen 81
+legitimierung 81
+verknüpfung 81
+lang 81
+zusammen 81
+standardausgabe 80
+variable 79
+dpkg 79
+referenzen 79
+voreinstellung 78
+ihre 78
+lokale 78
+eintrag 78
+element 77
+url 77
+inhalt 77
+symbolische 77
+alt 77
+suche 76
+text 76
+id 76
+siehe 76
+y 76
+letzten 75
+schließen 75
+aktualisiert 75
+länge 75
+ihr 74
+folgen 74
+holen 74
+zeigt 73
+hinweis 73
+einträge 73
+kommando 73
+dasselbe 73
+starten 72
+führen 72
+unbekannt 72
+q 72
+speichern 72
+prozess 71
+interner 71
+notwendig 71
+gültige 70
+jeder 70
+pid 70
+c 70
+no 70
+fehl 69
+sekunden 69
+konfiguration 69
+prüfen 69
+maximale 68
+erzwingen 68
+aktuelle 68
+sollte 68
+erfordert 68
+apt 68
+fehlende 68
+fehlendes 68
+weitere 67
+englisch 67
+rückgabewert 67
+teil 67
+was 67
+deaktiviert 66
+verwendung 66
+schlug 66
+erlauben 66
+nichts 66
+realm 66
+unbekanntes 65
+terminal 65
+angelegt 65
+magische 65
+branches 65
+war 64
+aktion 64
+parsen 64
+zwei 64
+liegt 64
+versuch 63
+erneut 63
+akzeptiert 63
+komponente 63
+leere 63
+verzeichnisse 63
+beginnen 62
+so 62
+akzenttasten 62
+installieren 61
+möchten 61
+wirklich 61
+suchen 61
+push 61
+ersten 60
+immer 60
+ausgeführt 60
+tags 60
+pfade 60
+tar 60
+stattdessen 59
+lokalen 59
+temporäre 59
+parameter 58
+unter 58
+kopieren 58
+senden 58
+außer 57
+abgebrochen 57
+beschreibung 57
+breite 57
+socket 56
+verknüpfungen 56
+crl 56
+andere 55
+dann 55
+unerwartetes 55
+anwendung 55
+laden 55
+auswählen 55
+empfangen 55
+help 55
+ebene 55
+form 54
+gültiger 54
+initialisieren 54
+aktualisierung 54
+benutze 54
+upstream 54
+mindestens 54
+geschlossen 54
+neuer 54
+blob 54
+nummer 53
+unterstützte 53
+aktualisierungen 53
+passt 53
+fehlender 53
+jede 53
+beschädigt 52
+gültig 52
+festlegen 52
+pro 52
+ob 52
+stat 52
+mittels 52
+falscher 52
+fenster 52
+ubuntu 52
+windows 52
+direktwert 52
+schlüsseltabelle 52
+ermittelt 51
+root 51
+konfigurationsdatei 51
+gnu 51
+entweder 51
+wort 51
+schlüssels 51
+erkannt 50
+nachricht 50
+konnten 50
+geladen 50
+nutzen 50
+gpg 50
+syntaxfehler 50
+operand 50
+submodule 50
+cherry 50
+stash 50
+letzte 49
+ab 49
+einmal 49
+wählen 49
+automatisch 49
+gruppen 49
+könnte 49
+sollten 49
+x 49
+http 49
+wechseln 49
+symbolischen 49
+offset 49
+selinux 49
+ausgegeben 48
+falsch 48
+gespeichert 47
+cd 47
+hash 47
+genau 47
+zeitstempel 47
+jedes 47
+filter 46
+leerer 46
+läuft 46
+abbruch 46
+abbrechen 46
+warten 46
+selbst 46
+jedoch 46
+angabe 46
+sun 46
+syntax 45
+unterdrücken 45
+signaturen 45
+erfolgreich 45
+veraltet 45
+anlegen 45
+konfiguriert 45
+bearbeiten 45
+dateiende 45
+umbenannt 45
+widerrufen 45
+pin 45
+zugriffsrechte 44
+definiert 44
+überprüft 44
+kontext 44
+gescheitert 44
+initialisiert 44
+hinzu 44
+dürfen 44
+konflikt 44
+antwort 44
+debian 44
+bekommen 44
+hinzugefügt 44
+hier 44
+weiteren 44
+verwerfen 44
+anfrage 44
+fetch 44
+ssl 44
+kerberos 44
+dvorak 44
+dictionary 44
+ausgaben 43
+überprüfen 43
+wegen 43
+uid 43
+architektur 43
+angewendet 43
+multi 43
+ldap 43
+strg 43
+seit 42
+block 42
+zugriff 42
+gesperrt 42
+leeren 42
+p 42
+verschieben 42
+umbenennen 42
+ressource 42
+ocsp 42
+lange 41
+neues 41
+ausführung 41
+bevor 41
+beginnt 41
+control 41
+attribute 41
+spalten 41
+regulärer 41
+schalter 41
+patches 41
+erwartete 41
+schema 41
+links 40
+abgelaufen 40
+sicher 40
+signieren 40
+ersetzen 40
+erkennen 40
+kommandos 40
+prozesse 40
+zeilenvorschub 40
+datum 40
+verschlüsselt 40
+richtlinie 40
+endung 39
+zusätzliche 39
+umgebungsvariable 39
+fehlerhafte 39
+gerade 39
+welche 39
+aktivieren 39
+art 39
+ascii 39
+entspricht 39
+t 39
+ignoriere 39
+pack 39
+client 39
+übergeben 38
+alias 38
+aktiviert 38
+abgefragt 38
+home 38
+dateisystem 38
+problem 38
+rechte 38
+phonetisch 38
+mib 37
+getrennt 37
+update 37
+auswahl 37
+desktop 37
+alternative 37
+bit 37
+u 37
+add 37
+unterschlüssel 37
+us 37
+reguläre 36
+melden 36
+überein 36
+erfolg 36
+installation 36
+deaktivieren 36
+abfragen 36
+ziffern 36
+einige 36
+möglicherweise 36
+verzeichnisses 36
+zeilennummer 36
+gerät 36
+sortieren 36
+feststelltaste 36
+tasten 36
+würde 35
+folgende 35
+änderung 35
+symbol 35
+ermitteln 35
+korrekt 35
+verändert 35
+verarbeitet 35
+download 35
+eigenschaft 35
+deren 35
+ihren 35
+direkt 35
+erste 35
+pipe 35
+unterschiede 35
+openpgp 35
+trigger 35
+insn 35
+kdc 35
+anstelle 34
+login 34
+details 34
+medium 34
+metadaten 34
+anwendungen 34
+vollständig 34
+unerwartete 34
+tree 34
+karte 34
+macintosh 34
+auslagerungsdatei 34
+zurücksetzen 33
+datenstrom 33
+information 33
+sollen 33
+unterstützung 33
+benutzername 33
+verarbeiten 33
+welches 33
+appstream 33
+ersetzt 33
+variablen 33
+basis 33
+gemeinsam 33
+committen 33
+deutsch 33
+französisch 33
+gleich 32
+beachten 32
+exit 32
+befehlszeile 32
+kennung 32
+paketes 32
+auflösen 32
+benutzers 32
+markiert 32
+zahlen 32
+methode 32
+erweiterung 32
+aufgerufen 32
+taste 32
+position 32
+posix 32
+setzt 32
+behandeln 32
+eigentümer 32
+systems 32
+linke 32
+revert 32
+notes 32
+bitmap 32
+hauptschlüssel 32
+link 31
+erstellung 31
+authentifizierung 31
+befehls 31
+ordner 31
+regulären 31
+ausdrucks 31
+zuerst 31
+menü 31
+aufgrund 31
+dateideskriptor 31
+normale 31
+verzeichnissen 31
+f 31
+anführungszeichen 31
+editieren 31
+zeige 31
+staging 31
+notizen 31
+gange 31
+trust 31
+russisch 31
+gui 31
+einstellungen 30
+prüfung 30
+en 30
+wieder 30
+gab 30
+nachrichten 30
+rechner 30
+vergleichen 30
+bestimmt 30
+ort 30
+er 30
+list 30
+sperren 30
+test 30
+info 30
+bibliothek 30
+m 30
+leitung 30
+unversionierte 30
+thread 30
+cache 30
+klonen 30
+ssh 30
+kommata 29
+threads 29
+unten 29
+mögliche 29
+lesefehler 29
+funktioniert 29
+entsperren 29
+herunterladen 29
+fertig 29
+gid 29
+verwende 29
+komma 29
+weil 29
+trotzdem 29
+stimmt 29
+attribut 29
+machen 29
+überschrieben 29
+existierende 29
+unix 29
+größer 29
+binäre 29
+zkette 29
+benutzung 29
+l 29
+passen 29
+würden 29
+checkout 29
+ticket 29
+auto 28
+start 28
+heruntergeladen 28
+auswerten 28
+etwas 28
+doppelpunkt 28
+lokal 28
+symbole 28
+übereinstimmung 28
+lassen 28
+operation 28
+angezeigt 28
+kindprozess 28
+weder 28
+weiter 28
+g 28
+regexp 28
+felder 28
+konflikte 28
+extrahieren 28
+shallow 28
+config 28
+anmeldedaten 28
+vs 28
+arabisch 28
+qwerty 28
+blöcke 27
+sparse 27
+ganzzahl 27
+überschritten 27
+gesamt 27
+alte 27
+annehmen 27
+sowohl 27
+internet 27
+vielleicht 27
+indem 27
+for 27
+entsprechend 27
+tage 27
+rekursiv 27
+zusammengeführte 27
+sektion 27
+conffile 27
+lese 27
+historie 27
+erhielt 27
+endianness 27
+specified 27
+unsupported 27
+conflicting 27
+values 27
+warc 27
+worden 26
+verweigert 26
+zwischenspeicher 26
+gestartet 26
+zusammenfassung 26
+gzip 26
+doppelter 26
+unerwarteter 26
+gleichzeitig 26
+set 26
+regel 26
+allen 26
+durchführen 26
+ok 26
+markieren 26
+meldung 26
+anfang 26
+sonst 26
+ausgewertet 26
+escape 26
+unerwartet 26
+k 26
+stil 26
+hex 26
+zusammenführen 26
+überspringen 26
+bits 26
+token 26
+achtung 26
+zurückgewiesen 26
+repositories 26
+prüfe 26
+auschecken 26
+fsmonitor 26
+daß 26
+verschlüsselungstyp 26
+fast 25
+xz 25
+wiederherstellen 25
+erreicht 25
+wollen 25
+kopiert 25
+überprüfung 25
+entferne 25
+stellen 25
+komponenten 25
+alten 25
+priorität 25
+archive 25
+aller 25
+protokoll 25
+port 25
+job 25
+while 25
+importieren 25
+genutzt 25
+tty 25
+präfix 25
+sicherung 25
+numerische 25
+code 25
+defekt 25
+master 25
+bisect 25
+benutzerzugang 25
+beibehalten 24
+inkompatibel 24
+flags 24
+sperre 24
+verwendete 24
+proxy 24
+füge 24
+probleme 24
+gültiges 24
+beide 24
+standardmäßig 24
+versionen 24
+fd 24
+anzuzeigen 24
+quellen 24
+abgeschlossen 24
+anzeige 24
+path 24
+listet 24
+anhängen 24
+ergebnis 24
+regeln 24
+skript 24
+quell 24
+h 24
+all 24
+prüfsumme 24
+denen 24
+minimale 24
+temporären 24
+tls 24
+verschlüsseln 24
+kvno 24
+rom 24
+cscope 24
+getrennte 23
+höchstens 23
+abhängigkeiten 23
+anstatt 23
+derzeit 23
+doppelte 23
+manuell 23
+reihenfolge 23
+vorhandene 23
+depot 23
+geholt 23
+uri 23
+interpretiert 23
+entsprechen 23
+ändert 23
+verhalten 23
+debug 23
+umleitung 23
+treffer 23
+hinter 23
+liegen 23
+befinden 23
+nul 23
+jetzt 23
+gegenstück 23
+make 23
+header 23
+auslassen 23
+fehlerhafter 23
+adressen 23
+versenden 23
+externen 23
+gssapi 23
+umschalttaste 23
+logitech 23
+anhand 22
+algorithmus 22
+kompression 22
+zeilenumbruch 22
+erforderliche 22
+klein 22
+schreibfehler 22
+zugeordnet 22
+eingeben 22
+ihrem 22
+paketname 22
+paketen 22
+veraltete 22
+tun 22
+setze 22
+länger 22
+dabei 22
+file 22
+metainfo 22
+normalerweise 22
+kernel 22
+per 22
+vollständige 22
+wahrscheinlich 22
+auszuführen 22
+verarbeitung 22
+quellpaket 22
+entpacken 22
+gesendet 22
+verz 22
+wo 22
+bestimmen 22
+versucht 22
+dns 22
+implementiert 22
+unterstützter 22
+zustand 22
+log 22
+principals 22
+undo 22
+übereinstimmungen 21
+kodierung 21
+versionsnummer 21
+nein 21
+ja 21
+bekannt 21
+zeigen 21
+freie 21
+gehört 21
+stehen 21
+erweitert 21
+genug 21
+fehlerhaft 21
+folgt 21
+kleinbuchstaben 21
+teile 21
+kurz 21
+ausgewählte 21
+wir 21
+lokaler 21
+host 21
+endet 21
+r 21
+arbeitsverzeichnisses 21
+flag 21
+alternativen 21
+wandeln 21
+orig 21
+admin 21
+unversionierten 21
+öffentlichen 21
+zertifikate 21
+hauptschlüssels 21
+zertifikats 21
+gstreamer 21
+disassembleroptionen 21
+kib 20
+mehreren 20
+begrenzung 20
+verändern 20
+maximal 20
+anderes 20
+versuche 20
+umgebung 20
+gewartet 20
+umgehen 20
+weniger 20
+lizenz 20
+verfügbare 20
+bedeutet 20
+beispiel 20
+umwandeln 20
+punkt 20
+zulässigen 20
+nutze 20
+ausgewählten 20
+aktuell 20
+pakets 20
+platz 20
+deb 20
+drücken 20
+durchgeführt 20
+öffentlicher 20
+nutzung 20
+ich 20
+script 20
+v 20
+inode 20
+haupt 20
+ls 20
+umwandlung 20
+alles 20
+unterbinden 20
+binär 20
+wann 20
+bzw 20
+partiellen 20
+ursprüngliche 20
+überspringe 20
+fehlen 20
+override 20
+objekten 20
+beheben 20
+whitespace 20
+reflog 20
+vormerken 20
+schlüsselserver 20
+dirmngr 20
+opcode 20
+movprfx 20
+ungarisch 20
+tastaturebene 20
+nfa 20
+gefolgt 19
+speicherbedarfsbegrenzung 19
+wobei 19
+ausgabedatei 19
+letzter 19
+auflösung 19
+ihnen 19
+halten 19
+abgerufen 19
+detaillierte 19
+nun 19
+kleiner 19
+argumenten 19
+kombination 19
+python 19
+stelle 19
+nutzer 19
+zusätzlich 19
+aktiv 19
+behalten 19
+reserviert 19
+konvertieren 19
+verzeichnisstapel 19
+erster 19
+gleichen 19
+namens 19
+verbleibenden 19
+angehalten 19
+lösche 19
+älter 19
+zeichenketten 19
+synchronisieren 19
+ihrer 19
+einfügen 19
+kombiniert 19
+endete 19
+erreichbar 19
+pull 19
+core 19
+vorgänger 19
+stashen 19
+geheimer 19
+pkcs 19
+geheimen 19
+microsoft 19
+meinten 18
+gewechselt 18
+sitzung 18
+dienst 18
+autor 18
+unicode 18
+zugegriffen 18
+ungleich 18
+type 18
+anderer 18
+verlangt 18
+o 18
+steht 18
+dessen 18
+konfigurieren 18
+operator 18
+angefordert 18
+reservieren 18
+cc 18
+jedem 18
+führt 18
+überlauf 18
+fehlermeldung 18
+globale 18
+sinnvoll 18
+rückschrägstrich 18
+mehrdeutig 18
+besitzer 18
+spalte 18
+relativ 18
+trennzeichen 18
+geprüft 18
+führe 18
+bau 18
+slave 18
+archivs 18
+erzwinge 18
+anfordern 18
+nachschlagen 18
+unzulässiger 18
+colemak 18
+nützlich 17
+lzma 17
+kurze 17
+damit 17
+fehlermeldungen 17
+suffix 17
+scheint 17
+verschoben 17
+daher 17
+wechsel 17
+passworts 17
+sicherheitskontext 17
+zugewiesen 17
+schnittstelle 17
+aufgelöst 17
+fügt 17
+einhängepunkt 17
+buchstaben 17
+kommentar 17
+source 17
+typs 17
+xml 17
+ausgewählt 17
+nachfragen 17
+keinem 17
+könnten 17
+tabulatoren 17
+klammern 17
+ihn 17
+zeitüberschreitung 17
+unterprozess 17
+fingerabdruck 17
+abschnitt 17
+show 17
+zuweisen 17
+vi 17
+laufenden 17
+zugreifen 17
+inhalte 17
+gleichbedeutend 17
+nullen 17
+auffüllen 17
+nehmen 17
+bemerkung 17
+gegebenen 17
+float 17
+prüfsummen 17
+zieldatei 17
+notation 17
+schreibe 17
+anforderung 17
+simple 17
+einstellung 17
+hook 17
+leeres 17
+merges 17
+import 17
+oid 17
+verfallen 17
+beglaubigung 17
+hashverfahren 17
+verfällt 17
+anmeldedatenzwischenspeicher 17
+ccache 17
+gedrückt 17
+lateinisch 17
+reiter 17
+meldungen 16
+warnungen 16
+fortgesetzt 16
+passwörter 16
+bereit 16
+legitimation 16
+erneuten 16
+bibliotheken 16
+festgelegt 16
+tabulator 16
+existierenden 16
+genügend 16
+dritten 16
+davon 16
+allgemeine 16
+seite 16
+optionale 16
+markierung 16
+kopfzeile 16
+abgeschaltet 16
+gesamtzahl 16
+ausschließlich 16
+falsches 16
+ganze 16
+einzelnes 16
+nächste 16
+ausdrücke 16
+erlaubte 16
+unterschiedlich 16
+vielfaches 16
+zeilenende 16
+unterstützten 16
+vierten 16
+gruppenname 16
+kommandozeile 16
+formatieren 16
+gegen 16
+fstat 16
+tiefe 16
+hilfemeldung 16
+passenden 16
+elf 16
+packen 16
+fortsetzen 16
+automatischen 16
+disassemblieren 16
+ausgecheckt 16
+sauber 16
+notiz 16
+gegenseite 16
+zwischenspeicherdatei 16
+unzulässiges 16
+unrecognized 16
+field 16
+belegung 16
+polnisch 16
+portugiesisch 16
+lettisch 16
+umbrechbares 16
+einfüge 16
+dateiformat 15
+gemäß 15
+trenner 15
+none 15
+symbolischer 15
+aufrufen 15
+bereitstellt 15
+bildschirm 15
+fortfahren 15
+rufen 15
+programms 15
+implizite 15
+warte 15
+beiden 15
+man 15
+benutzern 15
+bereitgestellt 15
+mehrfach 15
+kompatibel 15
+fall 15
+enden 15
+container 15
+bundle 15
+beziehen 15
+aufgetreten 15
+abfrage 15
+schlüsselbund 15
+zusammengeführt 15
+usb 15
+exportieren 15
+umgeleitet 15
+dirs 15
+eof 15
+jobs 15
+nachdem 15
+abhängig 15
+zuletzt 15
+aufheben 15
+vorherigen 15
+großbuchstaben 15
+wagenrücklauf 15
+elemente 15
+führende 15
+dekodieren 15
+trennen 15
+später 15
+ursprünglichen 15
+schablone 15
+existieren 15
+maschinenbefehl 15
+verloren 15
+widersprüchliche 15
+registriert 15
+entpackt 15
+doppeltes 15
+signiert 15
+sperrdatei 15
+erlaube 15
+max 15
+email 15
+false 15
+vorgemerkt 15
+tief 15
+worktree 15
+ca 15
+einrichten 15
+verbinden 15
+verweis 15
+flush 15
+zukunft 15
+servers 15
+öffne 15
+unzulässige 15
+seriennummer 15
+tofu 15
+vorangehenden 15
+registernamen 15
+ic 15
+shadow 15
+autokommandos 15
+benutzerdefinierte 14
+komprimierte 14
+cpu 14
+einheit 14
+automatische 14
+erzwungen 14
+benötigen 14
+legen 14
+wenig 14
+passende 14
+vertrauenswürdig 14
+gesichert 14
+gegenseitig 14
+public 14
+vermeiden 14
+niemals 14
+bild 14
+bearbeitung 14
+erwähnung 14
+nutzt 14
+urls 14
+exec 14
+teilen 14
+einzelnen 14
+sehen 14
+html 14
+ermöglichen 14
+zulässig 14
+jeden 14
+sieht 14
+umgewandelt 14
+gewöhnliche 14
+verbunden 14
+sockets 14
+laufwerk 14
+eingabetaste 14
+abhängigkeit 14
+kopfzeilen 14
+eingebauten 14
+zurückgegeben 14
+schleife 14
+nächsten 14
+oktal 14
+context 14
+endungen 14
+verhindern 14
+cr 14
+unverändert 14
+default 14
+dateityp 14
+löschung 14
+kopie 14
+nie 14
+rdatei 14
+verwendende 14
+ziele 14
+reicht 14
+beende 14
+ignorierte 14
+w 14
+originalautoren 14
+erkannte 14
+alter 14
+mmap 14
+angeforderte 14
+reset 14
+prune 14
+pfadspezifikation 14
+refspec 14
+unvollständiger 14
+dokument 14
+erweiterter 14
+gio 14
+audit 14
+metalink 14
+kopfteil 14
+tschechisch 14
+spanisch 14
+kurdisch 14
+viminfo 14
+delta 13
+filterkette 13
+optional 13
+sowie 13
+komprimiert 13
+pipeline 13
+hardware 13
+anmeldung 13
+gleiche 13
+rolle 13
+netzwerk 13
+akzeptieren 13
+symlink 13
+bearbeitet 13
+sig 13
+listen 13
+explizit 13
+vollständigen 13
+tastatur 13
+ziffer 13
+spezifiziert 13
+erweiterte 13
+sortiert 13
+oben 13
+stdout 13
+puffer 13
+entdeckt 13
+paketdatei 13
+verlassen 13
+kompilieren 13
+wiederholen 13
+temporärer 13
+i 13
+schlüsselwort 13
+range 13
+hintergrund 13
+quellcode 13
+gezählt 13
+gesucht 13
+erweiterten 13
+rechnername 13
+dritte 13
+ersetzung 13
+hängt 13
+ergibt 13
+pre 13
+vorherige 13
+unterbrochen 13
+zweiten 13
+richtig 13
+bsd 13
+große 13
+gemacht 13
+zweite 13
+umgebungsvariablen 13
+behandelt 13
+signale 13
+einlesen 13
+harte 13
+rekursive 13`,
+	},
+	"en": {
+		totals: [maxNgram + 1]int{0, 1191449, 1425827, 1191449},
+		counts: `
+e 143163
+t 103766
+i 94872
+n 94866
+o 93201
+a 85062
+r 80489
+s 75410
+l 52070
+d 49367
+c 47110
+u 37791
+p 33792
+m 32905
+f 31154
+h 27905
+g 26980
+b 19818
+y 16401
+w 13014
+v 11794
+k 9153
+x 6425
+z 2023
+q 1615
+j 1299
+e_ 43643
+t_ 29595
+in 27059
+s_ 25446
+d_ 25272
+_t 22150
+re 21282
+n_ 21196
+_s 19983
+_a 18947
+_i 18723
+er 17861
+on 17287
+or 16566
+_c 16046
+_f 15758
+r_ 15737
+te 15315
+_o 14877
+le 14125
+ti 13708
+ed 13515
+th 13264
+at 13253
+_n 12185
+se 11886
+an 11854
+es 11116
+ng 11114
+en 10856
+no 10832
+_r 10783
+o_ 10757
+st 10505
+_d 10437
+he 10413
+g_ 10344
+io 10201
+al 10153
+is 10145
+y_ 10002
+to 9926
+ec 9593
+co 9544
+_e 9369
+_p 9329
+nt 9086
+it 9034
+ar 9009
+_b 8997
+il 8621
+_u 8483
+li 8436
+_m 8351
+fi 8350
+ct 8290
+ot 8204
+de 8025
+nd 7732
+me 7700
+_w 7552
+l_ 6991
+_l 6976
+fo 6771
+ca 6714
+ro 6521
+ou 6480
+un 6210
+si 6159
+ma 6146
+ta 6143
+ch 5958
+pe 5820
+f_ 5816
+h_ 5805
+ne 5770
+us 5712
+na 5590
+ri 5585
+ra 5540
+ve 5517
+ge 5431
+ea 5300
+di 5298
+lo 5286
+as 5267
+ut 5215
+ns 5205
+a_ 5200
+et 5144
+of 4977
+ad 4903
+ex 4665
+ss 4653
+tr 4616
+ac 4534
+om 4523
+ha 4380
+pa 4328
+pr 4273
+el 4214
+be 4110
+va 4022
+am 3960
+ce 3959
+_g 3954
+id 3949
+ll 3890
+op 3875
+hi 3851
+ic 3791
+ab 3684
+em 3610
+_h 3601
+m_ 3561
+bl 3529
+_v 3383
+wi 3379
+oc 3326
+ur 3308
+rt 3304
+la 3264
+ai 3204
+po 3182
+ul 3180
+rr 3142
+mo 3131
+nc 3128
+pt 3096
+ol 3096
+up 3090
+ke 3038
+rs 2975
+um 2974
+ry 2954
+ow 2939
+ag 2909
+if 2895
+gi 2849
+mi 2840
+ig 2791
+su 2789
+mb 2787
+pl 2772
+p_ 2764
+ck 2751
+ir 2742
+ni 2610
+sp 2526
+mp 2524
+ef 2488
+c_ 2471
+fa 2465
+k_ 2414
+do 2388
+sh 2325
+od 2318
+ts 2256
+ie 2248
+im 2190
+pp 2185
+nv 2169
+gn 2130
+nn 2108
+rm 2087
+sy 2072
+ty 2071
+wa 2046
+da 2031
+ld 1990
+ly 1982
+mm 1959
+rn 1952
+eg 1945
+bo 1916
+ho 1900
+cr 1885
+_k 1885
+so 1877
+ia 1831
+iv 1813
+tu 1811
+nu 1797
+pu 1793
+ru 1792
+lt 1766
+x_ 1764
+lu 1760
+sa 1744
+ci 1738
+rg 1738
+nk 1730
+w_ 1726
+ue 1723
+ba 1700
+rc 1669
+ee 1655
+bu 1646
+xp 1619
+au 1598
+b_ 1581
+wh 1565
+ze 1552
+vi 1529
+ap 1518
+os 1504
+ip 1502
+ep 1493
+ey 1486
+rd 1485
+nf 1465
+ff 1444
+iz 1432
+ym 1419
+qu 1404
+ov 1400
+tt 1389
+mu 1362
+dd 1319
+yp 1312
+fr 1300
+bi 1298
+ay 1281
+oo 1275
+ui 1250
+cu 1243
+gu 1243
+ob 1221
+ev 1213
+by 1211
+fe 1193
+og 1187
+gr 1160
+u_ 1146
+_y 1146
+tc 1136
+nl 1136
+wo 1122
+uc 1107
+ib 1097
+sc 1091
+pi 1084
+ds 1081
+wn 1056
+eq 1051
+yo 963
+ls 954
+br 948
+fl 943
+cl 942
+xi 933
+we 925
+xt 923
+du 911
+tp 904
+av 872
+ki 867
+wr 855
+dr 840
+ub 830
+ys 816
+ft 807
+kn 792
+je 768
+ga 731
+ew 711
+fu 702
+bj 695
+ka 692
+i_ 687
+oe 678
+rk 674
+cc 637
+np 630
+gs 624
+fs 614
+ps 611
+ak 607
+ny 595
+oa 585
+yt 554
+dy 548
+ix 540
+yn 527
+sw 514
+ug 509
+dl 508
+go 504
+ks 502
+eb 501
+af 499
+rv 497
+bs 481
+tl 470
+sk 470
+tw 467
+xe 464
+pd 464
+ua 459
+ax 455
+ok 448
+rf 431
+ud 431
+_q 421
+rl 415
+gl 410
+gh 407
+pc 405
+ei 394
+hu 391
+oi 390
+fy 389
+_j 386
+nr 384
+uf 360
+ph 359
+sl 354
+sm 349
+_x 340
+lf 336
+_z 314
+lr 300
+nm 296
+gt 291
+ht 290
+vo 290
+rp 289
+ms 286
+cs 285
+eo 277
+lp 271
+sn 258
+xc 256
+gg 256
+hr 253
+cy 228
+py 226
+bm 224
+yi 212
+td 206
+gm 206
+rb 192
+jo 183
+rw 179
+kt 179
+lv 175
+gp 168
+ju 165
+dn 165
+xa 163
+pg 163
+ye 162
+hm 157
+mn 157
+v_ 156
+dp 153
+dw 150
+ku 150
+ik 149
+eu 145
+cp 142
+ml 140
+fd 139
+aw 134
+hs 133
+z_ 132
+q_ 132
+lg 131
+lm 129
+dt 128
+pk 125
+tf 124
+ek 121
+tm 120
+ws 119
+zi 118
+wl 117
+fp 114
+nh 112
+uo 110
+kg 109
+yl 108
+za 104
+cd 103
+sf 102
+ux 101
+dm 93
+bb 89
+dc 89
+sr 87
+dv 83
+ox 83
+eh 83
+kd 80
+db 79
+ja 79
+bt 78
+tn 78
+yw 77
+yr 74
+xu 70
+ko 69
+hh 67
+nw 65
+lw 63
+sd 63
+ej 63
+md 62
+cm 61
+bk 60
+mt 59
+nb 58
+bc 57
+lb 57
+yb 55
+ii 54
+dx 53
+bp 52
+xy 49
+gc 49
+hl 47
+hy 46
+df 46
+lc 45
+sb 45
+hn 45
+dj 44
+hd 44
+bf 42
+az 41
+mc 41
+vs 41
+kf 41
+vm 41
+vn 40
+j_ 39
+pm 39
+qw 39
+dg 38
+nz 38
+tg 36
+xm 36
+kp 36
+iu 35
+uk 35
+kv 34
+wd 34
+gy 34
+ya 34
+mr 34
+tb 33
+aj 32
+lx 32
+tv 31
+zo 31
+gf 31
+bd 31
+lz 30
+ae 30
+oj 30
+sv 30
+kc 29
+sq 29
+gz 28
+mk 28
+iq 28
+fm 27
+bn 27
+px 27
+vr 27
+oy 27
+kw 27
+gv 27
+lk 26
+cq 26
+vp 24
+xh 23
+oh 23
+ln 23
+fc 23
+cf 23
+tz 23
+xx 23
+vf 23
+cj 22
+dh 22
+tx 22
+cb 22
+tk 22
+aa 22
+rh 22
+xz 21
+cn 21
+zm 20
+kb 20
+ez 20
+gd 20
+yc 20
+kr 20
+pf 19
+mf 19
+km 19
+sg 19
+xs 18
+hc 18
+iw 18
+pw 17
+zl 17
+fx 17
+yg 17
+rx 17
+my 16
+mv 16
+hw 16
+pn 16
+aq 15
+kl 15
+ah 15
+cz 15
+cv 14
+hp 14
+gb 14
+ih 14
+bz 14
+zs 14
+xd 13
+fg 13
+fn 13
+js 13
+kh 13
+uu 12
+xo 12
+yy 12
+vt 12
+ao 12
+zy 12
+gw 12
+wg 12
+vd 12
+wm 12
+pb 11
+ji 11
+yz 11
+bx 11
+sz 11
+vl 11
+hf 10
+bg 10
+wp 10
+mx 10
+cw 9
+nq 9
+jp 9
+uh 8
+yd 8
+xr 8
+zr 8
+pq 8
+uv 8
+vx 8
+mh 7
+xf 7
+uz 7
+hv 7
+wc 7
+mw 6
+hb 6
+wx 6
+cg 6
+yf 6
+wf 6
+qq 6
+hk 6
+vw 6
+vg 6
+vc 6
+lh 6
+jc 6
+sx 6
+vb 6
+nj 6
+qd 6
+ky 5
+ww 5
+fw 5
+xl 5
+rq 5
+nx 5
+vy 5
+zb 5
+qt 5
+mg 5
+fq 5
+mz 5
+vu 4
+rz 4
+xb 4
+wt 4
+yv 4
+bv 4
+ed_ 12308
+_in 10859
+ion 9775
+on_ 9655
+_re 8997
+ng_ 8883
+ing 8843
+_th 8730
+tio 8030
+le_ 7781
+or_ 7665
+_co 7436
+_no 7335
+the 7208
+_to 7194
+not 6750
+ot_ 6695
+er_ 6647
+ile 6541
+to_ 6504
+he_ 6247
+es_ 6096
+_fi 5701
+ect 5667
+_fo 5521
+for 5424
+is_ 5009
+_se 4973
+in_ 4728
+fil 4655
+nd_ 4654
+_of 4580
+ent 4495
+of_ 4102
+ter 4054
+_is 3964
+te_ 3874
+and 3784
+_un 3741
+ati 3733
+_a_ 3675
+nt_ 3670
+cti 3551
+_us 3538
+ted 3510
+_ca 3500
+ate 3428
+re_ 3352
+se_ 3324
+val 3308
+_li 3239
+_de 3238
+_pr 3196
+_ex 3147
+_an 3140
+use 2997
+st_ 2975
+ble 2971
+_pa 2960
+_wi 2917
+_st 2903
+_op 2899
+_di 2898
+th_ 2887
+com 2864
+rea 2838
+ame 2831
+con 2830
+ut_ 2822
+id_ 2811
+ali 2797
+me_ 2788
+ge_ 2738
+_ma 2719
+it_ 2717
+al_ 2702
+ry_ 2698
+_be 2671
+can 2591
+_ar 2571
+ess 2566
+res 2564
+nam 2546
+an_ 2516
+ith 2474
+et_ 2468
+ist 2466
+abl 2465
+ver 2398
+lin 2386
+rec 2376
+wit 2370
+ead 2323
+cat 2279
+tin 2205
+err 2196
+lid 2192
+ns_ 2179
+all 2150
+sta 2141
+sec 2135
+_ch 2129
+at_ 2121
+_en 2114
+loc 2082
+as_ 2073
+ts_ 2069
+_al 2065
+_on 2054
+int 2029
+ine 2028
+ons 2021
+_su 2012
+out 2011
+_sy 1976
+pec 1975
+ch_ 1974
+ad_ 1963
+ve_ 1962
+ers 1932
+ly_ 1931
+ail 1928
+en_ 1924
+ste 1919
+ins 1913
+_do 1911
+ne_ 1903
+ire 1903
+inv 1891
+_er 1885
+ort 1878
+_na 1877
+_or 1866
+men 1862
+rro 1838
+nva 1837
+led 1826
+age 1825
+_lo 1823
+ror 1822
+tor 1796
+str 1794
+de_ 1794
+_si 1786
+ll_ 1781
+mat 1774
+be_ 1758
+ann 1752
+ce_ 1741
+nte 1729
+set 1716
+ran 1701
+sio 1686
+pti 1683
+nno 1678
+_fa 1668
+por 1662
+_va 1660
+rin 1630
+pro 1625
+pre 1621
+ld_ 1616
+omm 1610
+exp 1606
+ign 1605
+nst 1597
+no_ 1596
+ack 1578
+cha 1575
+ive 1575
+ssi 1559
+cte 1556
+red 1552
+_wh 1551
+_mo 1550
+_me 1548
+opt 1542
+sin 1534
+_sp 1528
+han 1513
+_ha 1495
+_ke 1479
+_wa 1462
+dat 1442
+fai 1427
+orm 1422
+thi 1422
+ss_ 1415
+_as 1397
+key 1394
+ang 1389
+ope 1386
+ode 1384
+_sh 1366
+ind 1364
+sym 1359
+_ou 1356
+are 1353
+dir 1343
+nge 1338
+rt_ 1334
+oca 1334
+ct_ 1328
+era 1325
+_ta 1323
+per 1318
+put 1317
+ont 1309
+ory 1305
+his 1295
+_ad 1286
+_nu 1285
+arg 1285
+rel 1283
+ize 1273
+man 1268
+_fr 1259
+tri 1259
+ore 1258
+upp 1254
+sup 1249
+ser 1247
+ifi 1244
+ica 1244
+num 1242
+ber 1241
+les 1233
+_gi 1226
+mbo 1223
+bol 1221
+spe 1220
+pe_ 1217
+ymb 1216
+pac 1209
+rs_ 1205
+war 1194
+add 1193
+rma 1192
+end 1191
+def 1187
+om_ 1183
+_ne 1179
+mbe 1177
+ult 1175
+eci 1173
+_tr 1172
+ol_ 1171
+_by 1166
+nin 1164
+ow_ 1157
+rom 1151
+che 1147
+omp 1136
+ren 1135
+_at 1131
+rat 1129
+_ba 1124
+mod 1123
+nde 1122
+ere 1121
+fie 1120
+_mi 1114
+ove 1113
+typ 1105
+ype 1097
+oun 1096
+reg 1094
+ue_ 1091
+ic_ 1089
+ume 1088
+_ve 1081
+ppo 1081
+tab 1078
+ite 1077
+ase 1076
+_mu 1075
+umb 1074
+cou 1073
+emo 1069
+_bu 1062
+_so 1061
+enc 1054
+ain 1047
+low 1044
+ay_ 1044
+tch 1042
+tur 1042
+elo 1040
+lis 1039
+own 1036
+fro 1035
+chi 1033
+rit 1032
+ces 1031
+equ 1031
+ure 1030
+rsi 1026
+_ty 1025
+ck_ 1022
+nal 1020
+dis 1019
+alu 1018
+tru 1018
+_he 1013
+rd_ 1010
+_da 1009
+cre 1009
+sed 1007
+und 1004
+uld 990
+oul 989
+iti 988
+_cr 988
+lue 982
+unk 981
+pat 973
+act 970
+din 968
+ple 968
+ass 966
+par 965
+egi 964
+eco 962
+ey_ 956
+mma 953
+eat 948
+llo 945
+rte 940
+her 939
+cif 937
+ds_ 933
+_t_ 929
+rem 926
+you 925
+ata 923
+git 920
+_ge 917
+cto 913
+wor 913
+har 908
+one 908
+fin 905
+ze_ 904
+ara 901
+lic 901
+arc 900
+ext 897
+ust 897
+_yo 896
+ntr 894
+pri 891
+ord 885
+lt_ 882
+_po 877
+ty_ 875
+est 874
+mes 865
+cod 854
+mis 854
+sig 854
+cal 853
+rge 852
+der 851
+wn_ 849
+rch 846
+req 844
+tem 842
+whi 841
+xpe 840
+now 835
+nab 832
+ref 832
+siz 829
+gis 829
+rac 825
+ern 825
+_b_ 824
+atc 818
+nly 818
+fer 818
+qui 815
+_it 814
+arn 809
+onl 808
+_le 807
+has 805
+pla 801
+tes 800
+mit 796
+hen 791
+ele 790
+kno 790
+_la 790
+nta 787
+tat 787
+our 787
+tha 777
+by_ 775
+nce 772
+cor 772
+sho 771
+ten 771
+tar 770
+utp 769
+tpu 767
+jec 766
+ach 763
+inf 761
+up_ 760
+uct 760
+nfo 756
+iss 754
+rni 753
+_im 753
+ied 753
+but 752
+get 752
+pen 751
+ruc 747
+nat 745
+exi 744
+_if 742
+_wr 741
+nor 733
+nkn 732
+atu 731
+ls_ 730
+pt_ 728
+if_ 725
+_ob 725
+una 724
+inc 723
+tra 721
+ert 721
+rep 713
+_te 710
+_up 710
+bra 709
+us_ 705
+rgu 704
+gum 704
+ide 704
+sh_ 699
+nts 695
+nti 695
+_bi 694
+ill 691
+wri 690
+ink 684
+aul 683
+cur 682
+fau 681
+bje 681
+ina 676
+ta_ 674
+efa 669
+do_ 667
+mus 667
+app 666
+obj 666
+ete 665
+_ra 663
+oes 663
+too 662
+mov 658
+_ap 658
+ex_ 654
+_au 651
+doe 651
+ou_ 649
+ned 649
+att 648
+onf 646
+hil 643
+uir 642
+ori 642
+ade 640
+anc 639
+ddr 639
+_br 638
+emp 637
+unt 634
+lea 634
+rou 633
+try 631
+ary 628
+rce 626
+sag 625
+tiv 624
+tim 624
+ner 623
+ote 620
+pli 619
+non 614
+_ac 613
+edi 613
+ace 612
+oo_ 611
+des 611
+off 610
+kag 610
+lat 608
+hin 607
+pos 605
+whe 605
+em_ 603
+ies 603
+tai 602
+tic 598
+ded 597
+gno 597
+_gr 596
+rre 595
+hea 595
+dre 594
+med 592
+ime 591
+cka 591
+_wo 589
+ini 588
+efi 588
+ock 586
+tre 585
+fic 584
+ges 582
+nch 582
+eve 579
+_ti 578
+_ab 578
+art 572
+min 566
+_ig 566
+nk_ 563
+ute 563
+tte 559
+_pl 558
+lay 551
+mor 550
+_cl 549
+rti 549
+tal 547
+let 545
+ene 543
+dex 543
+ari 542
+mpl 542
+len 539
+new 539
+fou 538
+bad 538
+ndi 535
+bas 535
+dif 535
+rna 533
+sti 528
+am_ 526
+ena 526
+any 526
+aut 525
+unc 524
+ard 523
+how 522
+loa 522
+hou 520
+gs_ 519
+kin 519
+mer 519
+spl 518
+_fu 518
+sub 517
+rev 517
+roc 516
+cke 516
+eas 515
+lle 511
+_ov 510
+sse 509
+_fl 508
+gna 507
+ecu 507
+oth 505
+mmi 504
+ee_ 504
+nit 501
+pas 500
+ram 500
+el_ 500
+tho 498
+nco 493
+sou 493
+erm 492
+hat 491
+_sa 490
+urc 489
+rie 488
+gen 488
+_id 487
+inp 486
+_sc 486
+usi 485
+ial 485
+del 480
+ny_ 480
+oce 479
+gin 479
+npu 478
+_s_ 478
+den 473
+ses 472
+ong 472
+uns 472
+ast 471
+lti 471
+gro 470
+run 469
+mpt 468
+ave 467
+ath 466
+osi 464
+win 463
+ven 462
+nds 461
+isp 461
+hel 461
+ar_ 461
+mpo 461
+_em 459
+mul 459
+erv 458
+ffe 457
+eme 455
+nes 453
+_cu 450
+ree 450
+ret 447
+_vi 445
+gra 444
+scr 440
+oup 440
+ppl 440
+met 440
+sel 440
+ffs 438
+_ru 438
+owe 437
+sto 435
+ew_ 434
+ork 431
+_pe 428
+lon 427
+tan 426
+its 426
+dia 426
+fse 424
+_af 424
+xt_ 423
+eri 423
+sit 421
+yte 420
+um_ 420
+orr 420
+eld 420
+byt 419
+eck 419
+ria 419
+hec 418
+eed 417
+xis 417
+efe 417
+oll 414
+mal 414
+evi 413
+ger 413
+ues 412
+tag 412
+mem 411
+nsi 410
+iel 409
+hiv 408
+ese 406
+ke_ 406
+tec 406
+gni 405
+nfi 402
+ant 401
+_du 401
+rip 400
+lar 400
+fte 399
+sys 399
+rmi 398
+urr 396
+imp 396
+cri 394
+pda 394
+isa 393
+odu 393
+que 393
+aft 392
+ity 389
+ule 388
+spa 387
+oad 387
+sen 386
+upd 386
+ssa 385
+fix 383
+ked 382
+ian 381
+acc 380
+tex 378
+ify 378
+dit 378
+yst 378
+erg 378
+fig 376
+rn_ 373
+oc_ 372
+ctu 371
+_pi 369
+epo 369
+cce 368
+ond 367
+ix_ 367
+log 367
+rve 367
+exe 366
+ks_ 365
+tia 364
+fol 363
+_ho 363
+may 363
+ash 362
+ag_ 361
+nre 361
+ice 360
+lem 360
+iff 358
+ict 357
+ake 357
+ett 355
+col 355
+fo_ 354
+bin 354
+fun 354
+adi 352
+dul 352
+tif 352
+ose 351
+unr 351
+ars 350
+cog 350
+lab 350
+lec 349
+nct 349
+ogn 348
+xpr 346
+she 345
+nex 345
+las 345
+ogr 344
+een 343
+ima 342
+iat 339
+eng 338
+lly 338
+var 338
+emb 338
+xte 338
+nsu 337
+ndl 337
+fla 336
+uff 335
+_bo 335
+hav 334
+sol 334
+ps_ 333
+ys_ 332
+ipt 331
+rar 331
+fy_ 330
+wed 328
+usa 328
+ell 328
+flo 328
+tti 327
+une 327
+bit 326
+lit 325
+tus 325
+giv 322
+zed 322
+dd_ 322
+ap_ 321
+oin 320
+nse 319
+xec 319
+rog 318
+mag 318
+ero 316
+was 316
+eac 315
+ear 315
+_ea 314
+ff_ 314
+mon 313
+dy_ 312
+hit 312
+pin 311
+_bl 310
+dow 310
+dle 310
+bac 310
+_n_ 309
+mak 308
+ega 308
+uth 307
+ppe 307
+pon 307
+op_ 307
+ost 306
+wil 306
+rib 306
+_pu 305
+xit 304
+niz 304
+ig_ 302
+pty 300
+lag 300
+rig 300
+ami 300
+ged 299
+ens 299
+rse 298
+mme 298
+_ro 298
+ous 297
+cut 297
+ona 294
+odi 294
+don 294
+ssw 294
+ro_ 293
+lib 292
+mpa 292
+ual 292
+mar 292
+erf 289
+syn 288
+ved 288
+tip 288
+eta 288
+_ce 288
+tro 288
+ols 288
+ket 288
+dep 287
+_av 287
+cts 287
+ady 286
+iab 286
+ito 286
+cac 286
+nda 284
+ema 284
+rol 284
+cop 284
+_qu 284
+cer 284
+deb 283
+irs 282
+clo 282
+ipl 282
+nee 281
+ur_ 281
+ibl 280
+_el 280
+ibu 280
+sam 280
+wer 279
+swo 278
+igh 278
+tea 277
+sor 277
+mic 277
+urn 276
+hes 275
+ede 275
+det 275
+nto 274
+dec 273
+eso 273
+un_ 273
+_hi 273
+_hu 273
+lre 272
+alr 271
+igu 271
+lac 271
+bug 269
+clu 268
+_ag 267
+ax_ 266
+imi 264
+ila 264
+mai 263
+lie 263
+poi 263
+blo 262
+rst 262
+ags 262
+_go 262
+eys 261
+ron 260
+ull 259
+rru 259
+leg 259
+fir 258
+ngt 257
+esc 257
+abi 257
+nne 257
+rup 256
+sma 255
+upt 255
+sca 255
+ttr 255
+zer 254
+sts 254
+ip_ 253
+hic 253
+gth 251
+sum 251
+sab 250
+ava 250
+ndo 250
+uil 249
+hun 249
+rk_ 248
+als 247
+efo 247
+ild 247
+nme 247
+uri 247
+eti 247
+ful 246
+ir_ 246
+mot 246
+ced 245
+lig 245
+exc 243
+ato 243
+rm_ 242
+uti 242
+mp_ 242
+mac 241
+aga 241
+_ev 240
+see 239
+ish 239
+gai 239
+ela 239
+top 238
+sha 238
+lte 236
+nar 236
+etu 236
+uni 236
+old 235
+_dy 235
+vai 234
+gur 234
+bui 233
+rl_ 233
+olu 232
+_we 231
+nci 231
+cip 231
+eam 230
+lud 229
+map 229
+sid 228
+bef 227
+dyn 227
+oli 226
+itt 225
+los 225
+ms_ 225
+eal 225
+ngl 224
+ude 224
+yna 224
+alt 223
+teg 223
+uto 223
+vin 223
+vel 223
+ili 223
+tac 222
+esp 222
+dar 221
+vio 221
+ich 221
+elp 221
+fli 221
+abo 220
+ans 220
+epe 220
+ker 220
+cks 219
+ome 219
+_sk 219
+ler 219
+ski 217
+ece 217
+ffi 216
+oke 216
+ebu 216
+nec 215
+sn_ 215
+ft_ 214
+_ze 213
+mpr 212
+kip 212
+nen 212
+rop 211
+eli 210
+ric 210
+_sw 209
+imm 209
+ark 209
+nfl 209
+ilt 208
+epa 208
+got 208
+ovi 207
+oft 207
+loo 207
+ncl 207
+lob 207
+cy_ 206
+ean 205
+vic 205
+_ur 205
+hed 204
+mas 204
+yin 204
+liz 204
+ght 203
+hem 203
+tly 202
+nis 202
+_fe 202
+hor 201
+lp_ 201
+sof 201
+ept 200
+lf_ 200
+aba 200
+max 199
+so_ 199
+_ot 198
+rri 198
+ngs 196
+ibr 196
+ubm 196
+vid 195
+ook 195
+eth 194
+ncr 194
+ege 193
+gal 193
+rid 193
+ma_ 192
+gne 192
+_il 192
+_ed 192
+opy 191
+car 191
+dic 190
+suc 190
+ora 190
+lim 189
+ula 189
+buf 189
+ssu 189
+_x_ 188
+bmo 188
+ug_ 187
+il_ 186
+elf 186
+ab_ 186
+ped 186
+imu 185
+pc_ 185
+tib 185
+sem 185
+onv 184
+ise 183
+ht_ 183
+mpi 183
+owi 182
+nve 182
+iou 182
+ec_ 182
+rot 182
+mbl 181
+ply 181
+ral 180
+ppi 180
+aus 180
+rki 180
+net 180
+onn 180
+pal 180
+ynt 179
+gme 179
+cum 179
+tax 178
+ors 178
+pil 177
+bee 176
+cs_ 176
+bel 175
+upl 175
+og_ 175
+isc 174
+nks 174
+ef_ 173
+epl 173
+mum 172
+gor 172
+hos 172
+ryp 172
+_ol 170
+shi 170
+cap 170
+ipa 170
+cry 170
+ypt 170
+std 167
+eca 167
+doc 167
+ota 166
+gre 166
+bot 166
+vim 166
+ura 165
+rus 165
+im_ 165
+lev 164
+olv 164
+ubl 164
+etw 163
+ocu 163
+bi_ 163
+ani 162
+unl 161
+twa 161
+cep 161
+cro 161
+seg 161
+sea 160
+sep 160
+ftw 160
+ia_ 160
+ets 159
+oni 159
+isi 159
+pco 159
+bou 158
+dup 158
+oat 158
+_sm 157
+fre 157
+ups 157
+_oc 157
+opc 157
+urs 156
+nu_ 156
+amp 155
+soc 155
+dde 155
+cla 154
+way 153
+gle 153
+two 153
+rme 153
+_gl 153
+py_ 153
+erp 152
+ddi 152
+ipp 152
+rde 152
+toc 152
+big 151
+gge 151
+olo 151
+egm 151
+_jo 151
+suf 150
+inu 150
+_sl 150
+os_ 150
+ump 150
+cas 150
+nke 150
+cau 149
+wee 148
+sib 148
+sk_ 148
+igi 148
+xce 147
+ano 147
+sla 147
+ak_ 147
+rfl 147
+erw 146
+ken 146
+gn_ 146
+wan 146
+abs 146
+bal 146
+rns 146
+orc 145
+ely 145
+bli 144
+lia 144
+_pc 144
+cki 143
+pte 143
+xtr 143
+ipe 142
+spo 142
+axi 141
+ush 141
+oot 141
+ob_ 141
+thr 140
+oss 140
+oma 140
+xpo 140
+ubs 140
+nlo 139
+gnu 139
+ale 139
+ike 138
+oba 138
+mmo 138
+bet 137
+tom 137
+eds 137
+via 137
+rap 137
+_d_ 137
+ntl 136
+etr 136
+cen 136
+bs_ 136
+epr 136
+lik 135
+ul_ 135
+dev 135
+url 135
+rob 135
+sa_ 135
+vir 134
+cia 134
+roo 134
+_ju 134
+glo 134
+gnm 134
+xim 133
+rc_ 133
+pag 133
+xpi 133
+pir 133
+ole 133
+_gn 133
+od_ 133
+etc 133
+wro 133
+ier 132
+nni 132
+_ps 132
+ism 132
+pu_ 131
+lan 131
+bre 131
+_am 129
+_tw 129
+ick 129
+_es 129
+tas 129
+ada 129
+lli 128
+dwa 128
+mbi 128
+gh_ 128
+pea 128
+ogi 127
+wid 126
+ros 126
+thm 126
+fet 126
+_ye 125
+bso 125
+kup 125
+job 125
+rif 124
+cho 124
+erl 124
+lve 124
+ask 124
+uch 124
+rne 124
+eba 124
+udi 123
+bun 123
+pid 123
+mou 122
+rov 122
+pol 122
+ien 122
+cle 122
+_c_ 122
+lum 122
+twe 121
+tit 121
+qua 121
+_ct 121
+ery 120
+io_ 120
+_cp 120
+ays 120
+reb 120
+pst 120
+rty 120
+ems 119
+pip 119
+_e_ 119
+exa 119
+dou 119
+_g_ 119
+rai 119
+alf 119
+nul 118
+ep_ 118
+riv 118
+lut 118
+gic 118
+nic 117
+rra 117
+sp_ 117
+cee 116
+erb 116
+ras 116
+bec 116
+rds 115
+wai 115
+til 114
+agi 114
+ait 114
+dn_ 114
+wo_ 114
+vis 114
+ewl 113
+rts 113
+abe 113
+_ga 113
+gat 113
+tls 113
+ib_ 112
+_ki 112
+dig 112
+hre 111
+_ph 111
+unn 111
+ici 111
+rpr 111
+ivi 111
+ws_ 111
+_gp 111
+_ow 110
+arr 110
+evo 110
+eep 109
+ves 109
+_dr 109
+ok_ 109
+pus 109
+mea 108
+tua 108
+did 108
+iva 108
+ein 107
+swi 107
+esu 107
+lfo 107
+cco 106
+ugh 106
+day 106
+son 106
+alg 106
+oco 106
+tel 105
+obs 105
+dth 105
+ibi 105
+ows 105
+tog 105
+idt 104
+uen 104
+sch 104
+pse 104
+ita 103
+ucc 103
+tam 103
+fac 103
+enu 103
+_tl 103
+seq 103
+oto 102
+pan 102
+lgo 102
+_ds 102
+_tu 102
+ech 102
+lus 101
+omi 101
+arm 100
+cpu 100
+ils 100
+eni 100
+som 100
+aps 100
+ths 100
+esn 100
+sso 99
+eek 99
+gli 99
+itc 99
+ego 99
+obl 99
+nc_ 99
+pto 99
+_ri 98
+tak 98
+siv 98
+apt 98
+hs_ 98
+aud 98
+xed 98
+stu 98
+vok 98
+cei 98
+nsn 98
+bor 97
+oug 97
+neg 97
+_eq 97
+eiv 97
+sco 96
+ncy 96
+ktr 96
+wne 95
+ias 95
+dea 95
+ecr 95
+_dp 95
+uts 95
+dur 94
+pic 94
+_fd 94
+sav 94
+nth 94
+rdi 94
+rvi 93
+dum 93
+omb 93
+aph 93
+hm_ 93
+onc 93
+ri_ 93
+unp 93
+alm 93
+lef 92
+pub 92
+eno 92
+uta 92
+env 91
+ixe 91
+eft 91
+wnl 91
+igg 91
+sul 91
+nca 91
+hex 91
+umn 91
+_ss 91
+arf 91
+lso 90
+ilu 90
+fd_ 90
+sty 90
+cin 90
+_dw 90
+ocs 90
+_fp 90
+lur 89
+tad 89
+bil 89
+hon 89
+pkg 89
+_y_ 89
+fra 89
+wli 88
+hoo 88
+ids 88
+efs 88
+tot 87
+nle 87
+rry 87
+yle 87
+rkt 87
+sc_ 86
+yet 86
+duc 86
+ugg 86
+sim 86
+ool 86
+dli 86
+rf_ 86
+avi 85
+ctl 85
+rke 85
+dr_ 85
+fec 84
+iro 84
+uit 84
+xcl 84
+ldn 84
+tsi 84
+ift 84
+tyl 84
+lm_ 84
+ppr 83
+nvi 83
+sis 83
+pho 83
+hum 83
+eak 83
+nki 83
+tub 83
+nim 82
+cie 82
+ksu 82
+kg_ 82
+hif 82
+rwr 81
+onm 81
+iza 81
+itu 81
+lor 81
+gio 81
+ync 81
+icy 81
+ub_ 81
+_q_ 81
+rla 81
+gul 80
+ico 80
+nel 80
+swa 80
+ek_ 80
+eyt 80
+ads 79
+due 79
+ana 79
+pes 79
+tp_ 79
+_cd 79
+_gu 79
+tdi 79
+oub 79
+plt 79
+lse 78
+cku 78
+eo_ 78
+cli 78
+ape 78
+_fs 78
+dpk 78
+acr 78
+_ld 78
+efu 77
+ra_ 77
+irt 77
+edu 76
+boo 76
+zat 76
+bly 76
+hig 76
+seu 76
+yta 76
+wou 75
+hom 75
+uid 75
+egu 75
+lla 75
+_ic 75
+rue 75
+dio 75
+hod 75
+wha 75
+hal 75
+gp_ 75
+_gs 75
+iev 74
+eit 74
+plu 74
+_ht 74
+rg_ 74
+wap 74
+ccu 74
+rag 73
+rad 73
+dom 73
+fs_ 73
+jus 72
+rtu 72
+quo 72
+uot 72
+_ei 72
+div 72
+fon 72
+cit 72
+npa 72
+ray 72
+eud 72
+udo 72
+oop 72
+pel 72
+ssp 72
+raw 71
+kee 71
+pai 71
+mli 71
+rfo 71
+rio 71
+ior 71
+ala 71
+tf_ 71
+ino 71
+mil 70
+yri 70
+yml 70
+nou 70
+anu 70
+gar 70
+bst 70
+xpa 70
+ux_ 70
+lts 69
+air 69
+ti_ 69
+oos 69
+ggi 69
+nha 69
+sue 69
+uer 69
+occ 69
+tle 69
+eou 68
+pad 68
+cem 68
+vat 68
+unm 68
+dro 68
+oge 68
+_ef 68
+hra 68
+sas 68
+bro 67
+nli 67
+thu 67
+kes 67
+gex 67
+aki 67
+abb 67
+bbr 67
+ugi 67
+pow 66
+mos 66
+rfa 66
+lax 66
+opp 66
+ml_ 66
+esk 66
+sl_ 66
+ane 65
+oti 65
+kil 65
+ats 65
+wis 65
+eff 65
+lds 65
+_mm 65
+phr 65
+tok 64
+skt 64
+kto 64
+uat 64
+els 64
+bia 64
+tna 64
+ips 64
+sph 64
+ris 63
+aw_ 63
+rod 63
+dan 63
+ntu 63
+tut 63
+eva 63
+hro 63
+lug 63
+slo 63
+ryi 62
+hei 62
+lde 62
+cel 62
+ewe 62
+_ui 62
+gui 62
+_ip 62
+rp_ 62
+itm 62
+deo 61
+ank 61
+unw 61
+lot 61
+crl 61
+dmi 60
+sur 60
+htt 60
+ttp 60
+beg 60
+ym_ 60
+zin 60
+mb_ 60
+eje 60
+asi 59
+fyi 59
+_kn 59
+nsa 59
+ida 59
+alw 59
+lwa 59
+tty 59
+sun 59
+tu_ 59
+hai 58
+ngi 58
+adm 58
+fe_ 58
+ics 58
+hab 58
+idd 58
+_bs 58
+_ja 58
+tma 58
+uce 57
+rim 57
+vie 57
+esi 57
+beh 57
+api 57
+pg_ 57
+gpg 57
+pop 57
+sal 57
+jum 57
+nod 57
+tos 57
+ubk 57
+bke 57
+tdo 56
+nag 56
+ado 56
+rul 56
+ctr 56
+_f_ 56
+lda 56
+nia 56
+mip 56
+pts 55
+bei 55
+_om 55
+ogg 55
+_i_ 55
+izi 55
+cta 55
+lif 55
+_cs 55
+ssl 55
+arp 55
+_dv 55
+fal 54
+rta 54
+nev 54
+dly 54
+_kd 54
+pr_ 54
+lls 54
+_m_ 54
+nux 54
+rej 54
+trl 54
+ixu 54
+xup 54
+unh 54
+rbe 54
+sua 53
+ebi 53
+vec 53
+nua 53
+xam 53
+mig 53
+_eo 53
+_cc 53
+oct 53
+nwi 53
+cim 52
+idi 52
+tis 52
+nue 52
+iso 52
+voc 52
+yth 52
+rab 52
+isk 52
+fus 52
+hib 52
+_ms 52
+eir 51
+fea 51
+pur 51
+oid 51
+eha 51
+apa 51
+ola 51
+rgi 51
+ecl 51
+mns 51
+rso 51
+eg_ 51
+cko 51
+gue 51
+sia 51
+sic 50
+bos 50
+rox 50
+dab 50
+ngu 50
+arb 50
+nvo 50
+dx_ 50
+rok 50
+_od 50
+cc_ 50
+_ec 50
+_dl 50
+vor 50
+bis 50
+dap 50
+_rs 50
+iew 49
+alo 49
+eof 49
+_tt 49
+pie 49
+_dn 49
+_ub 49
+ubu 49
+ctf 49
+fp_ 49
+lap 48
+_r_ 48
+_z_ 48
+fas 47
+rdw 47
+cov 47
+nus 47
+saf 47
+tie 47
+eb_ 47
+aci 47
+sci 47
+itl 47
+riz 47
+_ci 47
+sd_ 47
+bla 47
+pgp 47
+rba 47
+rak 47
+_ni 46
+egr 46
+zip 46
+emu 46
+afe 46
+lel 46
+rof 46
+nsl 46
+iag 46
+org 46
+lai 46
+pi_ 46
+dc_ 46
+rbo 45
+gid 45
+rei 45
+oxy 45
+xy_ 45
+_pk 45
+hol 45
+ldi 45
+gua 45
+mix 45
+miz 45
+pha 45
+nop 45
+ony 45
+inh 45
+_p_ 45
+kou 45
+dvo 45
+rks 44
+xac 44
+pul 44
+amb 44
+asc 44
+oku 44
+ogu 44
+uiv 44
+_u_ 44
+cis 44
+hip 44
+tl_ 44
+aux 44
+ttl 44
+db_ 44
+neo 43
+who 43
+hot 43
+nsf 43
+ige 43
+nos 43
+xp_ 43
+_ls 43
+csp 43
+adj 42
+la_ 42
+mpu 42
+ova 42
+dem 42
+bse 42
+cd_ 42
+rwi 42
+stn 42
+hme 42
+nym 42
+mn_ 42
+rew 42
+rer 42
+ev_ 42
+kdc 42
+upg 41
+hap 41
+pps 41
+phi 41
+lex 41
+lua 41
+phe 41
+ii_ 41
+rth 41
+hey 41
+_lt 41
+meo 40
+amo 40
+nyw 40
+ywa 40
+zes 40
+unu 40
+oki 40
+emi 40
+ood 40
+xpl 40
+efl 40`,
+		wordTotal: 234378,
+		words: `
+to 6128
+the 5988
+not 4614
+of 4042
+in 3784
+is 3739
+a 3675
+for 3602
+file 3318
+with 1910
+and 1838
+invalid 1833
+be 1721
+error 1711
+cannot 1651
+or 1617
+no 1521
+section 1490
+name 1414
+use 1323
+failed 1280
+this 1203
+from 1026
+as 950
+type 940
+t 929
+number 906
+set 876
+symbol 858
+key 845
+line 839
+b 824
+value 811
+git 796
+on 789
+can 786
+an 780
+only 769
+by 767
+output 759
+are 754
+option 749
+files 735
+version 732
+list 730
+unknown 730
+size 718
+warning 711
+directory 706
+if 701
+at 700
+register 685
+must 659
+has 658
+you 649
+could 643
+unable 638
+options 627
+read 620
+default 607
+used 606
+too 600
+missing 591
+command 577
+format 567
+do 554
+does 551
+while 547
+it 523
+found 519
+bad 519
+data 515
+relocation 499
+out 494
+object 494
+user 491
+index 482
+mode 481
+supported 481
+s 478
+when 476
+but 470
+all 469
+input 468
+argument 467
+range 463
+expected 449
+specified 447
+address 443
+create 442
+code 437
+entry 412
+instruction 408
+string 406
+package 405
+that 405
+non 402
+offset 401
+show 401
+using 398
+operand 398
+print 397
+branch 395
+one 394
+information 391
+commit 386
+after 385
+required 372
+archive 372
+header 368
+new 363
+group 363
+than 361
+table 355
+source 351
+may 349
+field 340
+display 339
+end 331
+write 325
+open 324
+change 321
+internal 318
+status 314
+system 313
+was 311
+n 309
+character 303
+without 302
+expression 298
+id 296
+usage 294
+remove 293
+unsupported 293
+will 293
+valid 292
+current 290
+empty 289
+find 288
+names 285
+add 284
+any 282
+have 278
+instead 272
+please 272
+already 270
+given 269
+time 269
+lines 267
+path 266
+ignored 263
+message 263
+password 261
+allowed 260
+reloc 259
+signature 258
+function 258
+same 257
+get 256
+local 256
+generate 256
+match 254
+memory 254
+support 254
+symbols 253
+each 252
+merge 251
+more 247
+unexpected 246
+exit 245
+length 244
+multiple 244
+bytes 240
+first 239
+arguments 237
+info 235
+target 235
+your 233
+program 230
+server 229
+before 227
+certificate 227
+variable 225
+remote 225
+link 222
+tag 222
+reading 220
+start 218
+characters 218
+long 217
+shell 216
+unrecognized 216
+changes 215
+into 214
+cache 213
+dynamic 212
+architecture 211
+which 210
+available 210
+specify 209
+run 209
+update 207
+image 204
+contains 199
+instructions 198
+process 195
+help 195
+don 194
+requires 194
+database 193
+check 190
+keys 190
+should 189
+authentication 188
+x 188
+last 185
+corrupt 185
+repository 185
+text 184
+illegal 183
+make 182
+got 182
+standard 181
+syntax 177
+commands 177
+zero 176
+against 174
+following 173
+uses 172
+been 172
+control 172
+contents 172
+sections 168
+up 166
+packages 165
+many 164
+tree 164
+immediate 163
+context 161
+note 160
+ignoring 160
+principal 160
+flags 159
+exists 159
+values 158
+software 158
+writing 156
+submodule 156
+signal 155
+entries 155
+library 154
+objects 154
+reference 153
+both 152
+base 152
+record 151
+hunk 151
+there 150
+lock 149
+large 149
+byte 149
+directive 145
+see 144
+binary 143
+defined 142
+document 142
+attribute 142
+stack 141
+script 140
+space 140
+buffer 139
+opcode 138
+d 137
+full 137
+count 137
+config 136
+pattern 135
+patch 135
+delete 134
+debug 134
+diff 133
+allow 132
+component 131
+shared 131
+copy 131
+maximum 130
+setting 130
+level 130
+matching 130
+exist 129
+other 128
+call 128
+word 127
+abi 127
+load 126
+changed 126
+hash 126
+working 125
+constant 125
+duplicate 124
+filename 123
+parse 123
+its 123
+previous 122
+ignore 122
+destination 122
+configuration 122
+override 122
+c 122
+segment 121
+head 121
+undefined 121
+wrong 120
+elf 120
+e 119
+g 119
+search 118
+access 118
+want 118
+overflow 118
+pack 118
+old 117
+block 116
+try 116
+between 115
+about 115
+select 115
+window 115
+creating 114
+bit 113
+build 113
+vim 113
+existing 112
+selected 111
+location 111
+flag 110
+enter 110
+alignment 110
+like 109
+need 109
+contain 109
+directories 108
+different 107
+extension 107
+apply 107
+malformed 106
+global 106
+enable 105
+registers 105
+symbolic 104
+installed 104
+two 104
+gnu 104
+date 104
+operands 103
+pc 103
+url 102
+device 102
+mark 102
+temporary 102
+disable 101
+them 101
+big 100
+operation 100
+doesn 100
+suffix 99
+incompatible 99
+include 99
+export 99
+attributes 99
+pid 99
+connection 98
+socket 98
+ref 98
+removed 97
+point 97
+created 97
+follow 97
+edit 97
+common 97
+specific 96
+even 95
+small 95
+application 95
+width 95
+because 94
+definition 94
+member 93
+stat 93
+request 93
+needs 92
+security 92
+attempt 92
+algorithm 92
+master 92
+limit 91
+import 91
+cpu 90
+root 90
+integer 89
+report 89
+y 89
+tls 89
+also 88
+skipping 88
+running 88
+sort 87
+case 87
+notes 87
+returns 87
+structure 87
+mismatch 87
+comma 86
+bits 86
+yet 86
+such 86
+extended 86
+links 85
+errors 85
+getting 85
+left 85
+send 85
+encoding 85
+magic 85
+filter 84
+single 84
+failure 84
+label 84
+core 84
+store 84
+worktree 84
+isa 84
+force 83
+element 83
+starting 83
+execute 83
+requested 83
+off 83
+possible 82
+auto 82
+return 82
+rebase 82
+realm 82
+total 81
+terminal 81
+another 81
+absolute 81
+numbers 81
+references 81
+convert 81
+policy 81
+q 81
+dwarf 81
+stream 80
+disabled 80
+unless 80
+environment 80
+tags 80
+part 80
+processing 80
+then 80
+style 80
+skip 80
+rename 80
+machine 79
+due 79
+dir 79
+handle 79
+page 78
+removing 78
+special 78
+couldn 78
+dead 78
+encountered 78
+dpkg 78
+detected 78
+executable 78
+prefix 77
+compiled 77
+log 77
+relocations 77
+stash 77
+module 76
+did 76
+ids 76
+insn 76
+short 75
+regular 75
+public 75
+floating 75
+shift 75
+linker 75
+position 74
+install 74
+so 74
+named 74
+fetch 74
+resource 74
+would 73
+currently 73
+newline 73
+headers 73
+present 73
+updates 73
+order 73
+sign 73
+close 73
+relative 73
+host 73
+commits 73
+plt 73
+login 72
+metadata 72
+second 72
+keytab 72
+were 71
+true 71
+upstream 71
+least 71
+conflict 71
+outside 71
+linking 71
+none 70
+service 70
+apt 70
+later 70
+replace 70
+what 70
+char 70
+push 70
+num 69
+parameter 69
+deprecated 69
+expecting 69
+conflicts 69
+reset 68
+supplied 68
+alias 68
+state 68
+work 68
+branches 68
+compression 67
+backup 67
+where 67
+per 67
+save 67
+switch 67
+job 67
+during 66
+content 66
+how 66
+allocate 66
+pseudo 66
+warn 66
+alt 66
+enabled 65
+right 65
+protocol 65
+descriptor 65
+paths 65
+some 64
+nothing 64
+audio 64
+response 64
+problem 64
+sequence 64
+here 64
+received 64
+unit 64
+swap 64
+template 63
+property 63
+tab 63
+hex 63
+float 63
+deleted 63
+pointer 63
+addressing 63
+sparse 62
+most 62
+resolve 62
+category 62
+adding 62
+extra 62
+specification 62
+back 62
+class 62
+tar 62
+messages 61
+closing 61
+english 61
+credentials 61
+configured 61
+uri 61
+together 61
+move 61
+double 61
+conflicting 61
+inside 61
+null 60
+seconds 60
+item 60
+action 60
+addresses 60
+linked 60
+untracked 60
+pin 60
+arm 59
+keep 59
+home 59
+signed 59
+updated 59
+method 59
+dependency 59
+macro 59
+month 59
+passphrase 59
+checking 58
+debugging 58
+always 58
+form 58
+re 58
+negative 58
+fields 58
+blob 58
+pipe 57
+expired 57
+insert 57
+desktop 57
+bundle 57
+enough 57
+fd 57
+incorrect 57
+completion 57
+handling 57
+stdin 57
+original 57
+normal 56
+token 56
+interface 56
+free 56
+array 56
+modified 56
+greater 56
+f 56
+parsing 56
+client 56
+being 55
+multi 55
+fix 55
+details 55
+pass 55
+modules 55
+i 55
+history 55
+refs 55
+since 54
+session 54
+groups 54
+symlink 54
+executed 54
+font 54
+operator 54
+determine 54
+numeric 54
+processes 54
+loop 54
+m 54
+us 54
+external 54
+unhandled 54
+endian 53
+trying 53
+download 53
+accept 53
+via 53
+strings 53
+child 53
+separate 53
+ctrl 53
+dictionary 52
+either 52
+video 52
+color 52
+within 52
+next 52
+split 52
+checksum 52
+map 52
+plugin 52
+cherry 52
+crl 52
+ticket 52
+literal 51
+verify 51
+these 51
+description 51
+done 51
+users 51
+menu 51
+progress 51
+variables 51
+frame 51
+columns 51
+again 50
+waiting 50
+uid 50
+really 50
+result 50
+conversion 50
+http 50
+making 50
+arg 50
+hunks 50
+packet 50
+encrypted 50
+stub 50
+caps 50
+displacement 50
+assembler 50
+region 50
+alternative 49
+settings 49
+hard 49
+needed 49
+matches 49
+indirect 49
+schema 49
+treat 49
+sun 49
+selinux 49
+implemented 49
+ubuntu 49
+permissions 48
+longer 48
+success 48
+consider 48
+media 48
+optional 48
+patterns 48
+r 48
+z 48
+max 48
+discard 48
+truncated 48
+restore 48
+unrecognised 48
+secret 48
+thumb 48
+ctf 48
+chain 47
+account 47
+overwrite 47
+suppress 47
+test 47
+hardware 47
+their 47
+parameters 47
+signatures 47
+never 47
+dump 47
+container 47
+marked 47
+times 47
+top 47
+disk 47
+debian 47
+gpg 47
+conditional 47
+nul 47
+thread 47
+real 46
+card 46
+generated 46
+types 46
+jump 46
+owner 45
+stdout 45
+timestamp 45
+once 45
+trailing 45
+append 45
+added 45
+p 45
+kerberos 45
+fast 44
+every 44
+active 44
+remaining 44
+raw 44
+written 44
+still 44
+day 44
+trigger 44
+pair 44
+compare 44
+items 44
+kernel 44
+release 44
+email 44
+broken 44
+sent 44
+deleting 44
+opening 44
+u 44
+dvorak 44
+take 43
+processor 43
+changing 43
+proxy 43
+automatically 43
+updating 43
+now 43
+continue 43
+might 43
+unset 43
+pipeline 43
+leading 43
+parallel 43
+bitmap 43
+mapping 43
+revocation 43
+subkey 43
+encryption 43
+ocsp 43
+model 43
+mips 43
+locked 42
+initialize 42
+referenced 42
+skipped 42
+revision 42
+ssl 42
+ldap 42
+addr 42
+kdc 42
+recompile 42
+phonetic 42
+relocs 42
+terminated 41
+network 41
+libraries 41
+loaded 41
+general 41
+just 41
+vector 41
+simple 41
+otherwise 41
+hostname 41
+they 41
+exclude 41
+applied 41
+checkout 41
+toc 41
+assemble 41
+minimum 40
+anyway 40
+versions 40
+complete 40
+appstream 40
+permitted 40
+listed 40
+reverse 40
+assume 40
+locate 40
+equivalent 40
+initializing 40
+points 40
+initial 40
+clone 40
+mask 40
+turn 40
+private 40
+triggers 40
+recognized 39
+bug 39
+sizes 39
+stored 39
+delay 39
+trust 39
+license 39
+containing 39
+takes 39
+builtin 39
+assumed 39
+according 39
+tty 39
+included 39
+windows 39
+gui 39
+addend 39
+timeout 38
+defaults 38
+verbose 38
+separated 38
+known 38
+signing 38
+dependencies 38
+parent 38
+less 38
+whitespace 38
+returned 38
+metainfo 38
+lower 38
+over 38
+lookup 38
+quit 38
+corresponding 38
+column 38
+strip 38
+seek 38
+revert 38
+blocks 37
+provide 37
+sure 37
+digits 37
+positive 37
+fill 37
+bind 37
+cd 37
+repeat 37
+separator 37
+stop 37
+prompt 37
+posix 37
+pre 37
+condition 37
+octal 37
+regexp 37
+abbreviated 37
+targets 37
+arch 37
+little 37
+revoked 37
+allocating 37
+choose 36
+rather 36
+formats 36
+virtual 36
+aborting 36
+connect 36
+args 36
+equal 36
+escape 36
+words 36
+below 36
+openpgp 36
+slot 36
+latin 36
+followed 35
+compressed 35
+selection 35
+example 35
+digest 35
+port 35
+jobs 35
+eof 35
+preceding 35
+l 35
+begin 35
+larger 35
+patches 35
+macintosh 35
+unwind 35
+additional 34
+spaces 34
+automatic 34
+sorry 34
+username 34
+wait 34
+exiting 34
+modify 34
+provided 34
+runtime 34
+called 34
+unix 34
+printing 34
+kill 34
+delimiter 34
+sense 34
+bisect 34
+credential 34
+issuer 34
+german 34
+russian 34
+pic 34
+sym 34
+useful 33
+threads 33
+exceeded 33
+correct 33
+provides 33
+combination 33
+problems 33
+handler 33
+compatible 33
+priority 33
+verification 33
+substitution 33
+precision 33
+fsmonitor 33
+exceeds 33
+arabic 33
+french 33
+filenames 32
+lists 32
+abort 32
+unsigned 32
+false 32
+gid 32
+colon 32
+compatibility 32
+means 32
+domain 32
+specifies 32
+appear 32
+we 32
+perform 32
+functions 32
+locale 32
+dns 32
+built 32
+merged 32
+far 32
+w 32
+orig 32
+submodules 32
+undo 32
+scalar 32
+beyond 32
+fpu 32
+intel 32
+win 32
+mmo 32
+stubs 32
+passed 31
+decimal 31
+mount 31
+removal 31
+comment 31
+components 31
+based 31
+ascii 31
+isn 31
+closed 31
+listing 31
+retrieving 31
+occurs 31
+effect 31
+corrupted 31
+fail 31
+renamed 31
+extract 31
+nested 31
+fit 31
+fixup 31
+layout 31
+ssh 31
+recognize 31
+mail 30
+fingerprint 30
+avoid 30
+writable 30
+define 30
+dot 30
+look 30
+place 30
+archives 30
+mean 30
+disc 30
+execution 30
+records 30
+exactly 30
+keyword 30
+ambiguous 30
+through 30
+binding 30
+generation 30
+backslash 30
+align 30
+ownership 30
+main 30
+unexpectedly 30
+overlay 30
+relocatable 30
+keyserver 30
+def 30
+depth 29
+warnings 29
+supports 29
+creation 29
+trusted 29
+applications 29
+installing 29
+fatal 29
+newer 29
+primary 29
+sources 29
+language 29
+installation 29
+behavior 29
+exception 29
+legacy 29
+modification 29
+keyring 29
+merging 29
+ok 29
+else 29
+fixed 29
+smaller 29
+final 29
+specifier 29
+shallow 29
+garbage 29
+protected 29
+unaligned 29
+whereas 29
+arp 29
+nfa 29
+flush 28
+expire 28
+days 28
+identifier 28
+clear 28
+resolving 28
+power 28
+complex 28
+exec 28
+o 28
+odd 28
+weak 28
+indexed 28
+temp 28
+track 28
+refusing 28
+obsolete 28
+subject 28
+conffile 28
+implicit 28
+admin 28
+fp 28
+ccache 28
+qwerty 28
+amount 27
+immediately 27
+refer 27
+under 27
+xml 27
+unsafe 27
+good 27
+namespace 27
+generic 27
+executing 27
+something 27
+pathname 27
+combined 27
+k 27
+alternate 27
+extensions 27
+preserve 27
+hint 27
+put 27
+replay 27
+prune 27
+incremental 27
+rejected 27
+emulation 27
+kvno 27
+intl 27
+dso 27
+erratum 27
+relaxation 27
+give 26
+permission 26
+loading 26
+gzip 26
+locally 26
+human 26
+origin 26
+itself 26
+expr 26
+major 26
+h 26
+recursive 26
+stage 26
+expiration 26
+implement 26
+sp 26
+warc 26
+bfd 26
+cscope 26
+unlock 25
+author 25
+unused 25
+require 25
+copyright 25
+stopped 25
+feature 25
+editing 25
+necessary 25
+way 25
+spec 25
+reserved 25
+whose 25
+arithmetic 25
+upper 25
+v 25
+high 25
+interpreted 25
+minor 25
+recursively 25
+resolution 25
+unmatched 25
+area 25
+assuming 25
+packed 25
+junk 25
+fetching 25
+rev 25
+unmerged 25
+endianness 25
+disassembler 25
+vs 25
+psect 25
+interworking 25
+filters 24
+encode 24
+switching 24
+letters 24
+manually 24
+os 24
+probably 24
+associated 24
+registered 24
+acquire 24
+dirs 24
+processed 24
+sub 24
+until 24
+effective 24
+differ 24
+implies 24
+sending 24
+applies 24
+quiet 24
+partial 24
+clean 24
+channel 24
+inconsistent 24
+volume 24
+certificates 24
+self 24
+rsrc 24
+rule 24
+gssapi 24
+internet 24
+rom 24
+cursor 24
+loader 24
+custom 23
+decode 23
+mandatory 23
+padding 23
+monitor 23
+forced 23
+searching 23
+suitable 23
+accepted 23
+looking 23
+unicode 23
+well 23
+building 23
+strategy 23
+random 23
+query 23
+segments 23
+plain 23
+sync 23
+transfer 23
+subprocess 23
+break 23
+helper 23
+ip 23
+readonly 23
+rules 23
+post 23
+white 23
+initialized 23
+nonzero 23
+bsd 23
+represent 23
+ls 23
+quote 23
+stderr 23
+giving 23
+lacks 23
+emit 23
+predicate 23
+reason 23
+mapped 23
+cipher 23
+serial 23
+dsp 23
+gp 23
+routing 23
+hungarian 23
+logitech 23
+eabi 23
+lto 23
+low 22
+yes 22
+insufficient 22
+generating 22
+icon 22
+older 22
+summary 22
+exclusive 22
+fork 22
+parts 22
+cause 22
+urls 22
+python 22
+printed 22
+regex 22
+drop 22
+statistics 22
+assignment 22
+checked 22
+specifications 22
+go 22
+neither 22
+makes 22
+echo 22
+made 22
+dest 22
+blank 22
+int 22
+encoded 22
+modes 22
+tracking 22
+auxiliary 22
+histogram 22
+discarded 22
+trustdb 22
+preferences 22
+packets 22
+dll 22
+spreadsheet 22
+coff 22
+ent 22
+delta 21
+twice 21
+mib 21
+backend 21
+relax 21
+tabs 21
+accessed 21
+correctly 21
+elements 21
+direct 21
+kind 21
+previously 21
+unpack 21
+manual 21
+family 21
+shown 21
+omit 21
+whether 21
+letter 21
+nor 21
+hexadecimal 21
+units 21
+inode 21
+except 21
+platform 21
+graph 21
+numbered 21
+storage 21
+incomplete 21
+slave 21
+unresolved 21
+batch 21
+highlight 21
+imported 21
+encrypt 21
+gstreamer 21
+ppc 21
+insns 21
+gcc 21
+aligned 21
+reg 21
+prologue 21
+avr 21
+nop 21
+powerpc 20
+xz 20
+assigned 20
+copying 20
+unavailable 20
+static 20
+systems 20
+body 20
+scan 20
+keyboard 20
+issue 20
+preferred 20
+sorted 20
+configure 20
+deb 20
+press 20
+reply 20
+overwritten 20
+assign 20
+allocated 20
+background 20
+bogus 20
+specifying 20
+stamp 20
+operators 20
+differs 20
+recursion 20
+counts 20
+expand 20
+indicate 20
+trailer 20
+notation 20
+applying 20
+filesystem 20
+renaming 20
+dangerous 20
+hook 20
+detached 20
+reflog 20
+mnemonic 20
+ones 20
+pkcs 20
+dirmngr 20
+vma 20
+lib 20
+omitted 19
+moved 19
+works 19
+verifying 19
+continuing 19
+kib 19
+critical 19
+denied 19
+term 19
+started 19
+refresh 19
+downloading 19
+upgrade 19
+finished 19
+readable 19
+tool 19
+usually 19
+become 19
+recommended 19
+cdrom 19
+interactive 19
+know 19
+above 19
+dumped 19
+redirect 19
+clock 19
+cc 19
+replaced 19
+synonym 19
+locations 19
+scope 19
+operations 19
+unique 19
+modifier 19
+inhibit 19
+am 19
+checksums 19
+logged 19
+truncate 19
+fstat 19
+lost 19
+past 19
+maintainer 19
+actions 19
+expects 19
+pathspec 19
+room 19
+calling 19
+buffers 19
+rc 19
+microsoft 19
+whilst 19
+opd 19
+retrieve 18
+repo 18
+task 18
+screen 18
+similar 18
+detailed 18
+pool 18
+mutually 18`,
+	},
+	"es": {
+		totals: [maxNgram + 1]int{0, 1221174, 1459939, 1221174},
+		counts: `
+e 162247
+a 126158
+o 107804
+r 90230
+n 87984
+i 87982
+s 76813
+d 67927
+c 64697
+l 61170
+t 58802
+u 39000
+p 34856
+m 33735
+b 19062
+f 15939
+v 14138
+g 13382
+ó 12158
+h 9599
+á 5973
+q 5230
+y 4249
+í 3992
+z 3848
+j 3741
+x 3623
+ú 2198
+k 1252
+ñ 1196
+é 1154
+w 1006
+º 11
+ü 8
+ò 4
+o_ 48244
+e_ 45776
+a_ 38529
+de 33158
+_d 32014
+_e 31560
+s_ 26000
+n_ 24577
+en 21985
+es 21802
+_s 20491
+ar 20163
+er 19129
+_c 18208
+r_ 18107
+_p 17170
+l_ 16827
+re 16802
+ra 16564
+_a 15526
+do 15258
+_l 14991
+_n 14586
+ci 14256
+no 13986
+co 13739
+la 13437
+el 13058
+se 13034
+nt 12651
+te 12590
+or 12545
+ad 12323
+on 12087
+in 12011
+al 11876
+ta 11563
+os 11045
+st 10654
+ca 10104
+ro 9814
+to 9459
+ec 9356
+ic 9200
+ón 8950
+ue 8937
+_u 8762
+ió 8754
+_r 8466
+_f 8407
+da 8226
+_i 8118
+tr 8112
+li 7845
+ti 7781
+lo 7523
+as 7497
+pa 7477
+ac 7451
+id 7370
+un 7284
+_m 6931
+ma 6913
+si 6905
+ne 6838
+fi 6743
+na 6715
+an 6691
+ri 6611
+_o 6571
+io 6474
+_t 6371
+le 6177
+om 6138
+di 6092
+po 5766
+me 5627
+it 5454
+mi 5355
+ch 5253
+nd 5170
+qu 5036
+is 4904
+pe 4902
+pu 4579
+ct 4559
+ce 4491
+_v 4435
+am 4418
+nc 4277
+pr 4238
+ed 4218
+ia 4214
+et 4145
+mb 4124
+ie 4101
+so 3965
+ir 3894
+iv 3862
+sa 3793
+mp 3629
+bi 3617
+ab 3615
+mo 3611
+_b 3530
+vo 3510
+em 3495
+op 3482
+at 3416
+sp 3394
+ea 3276
+ve 3273
+bl 3157
+t_ 3155
+us 3139
+he 3109
+va 3055
+ni 3043
+rr 3014
+cc 3005
+_h 3000
+im 2966
+_g 2960
+rm 2952
+br 2927
+sc 2921
+rc 2918
+ol 2912
+oc 2811
+eg 2742
+za 2676
+gu 2648
+y_ 2589
+gi 2541
+ex 2536
+rt 2501
+ut 2490
+ns 2470
+ig 2404
+ll 2398
+cu 2377
+ha 2361
+il 2350
+if 2336
+tu 2294
+su 2283
+ua 2282
+cr 2262
+hi 2255
+ur 2253
+_q 2251
+pl 2226
+d_ 2214
+ib 2170
+ob 2161
+od 2047
+fa 2016
+ru 1943
+_y 1920
+ba 1897
+je 1893
+ál 1892
+ui 1892
+vi 1828
+vá 1812
+cl 1789
+av 1749
+iz 1736
+bo 1718
+ef 1699
+pc 1687
+lt 1677
+fo 1648
+ip 1592
+be 1558
+tá 1557
+ó_ 1531
+rs 1516
+ub 1512
+ot 1503
+nv 1501
+rd 1486
+um 1463
+ín 1461
+nf 1453
+gr 1436
+fu 1433
+uc 1429
+lí 1406
+á_ 1393
+ga 1389
+ud 1386
+ng 1339
+ul 1324
+rg 1324
+fe 1291
+ap 1265
+go 1261
+i_ 1217
+ep 1208
+ev 1202
+ge 1189
+pi 1179
+eb 1172
+nu 1131
+ím 1091
+up 1043
+ej 1020
+ag 998
+du 980
+lu 953
+au 952
+p_ 942
+mu 940
+aj 935
+rá 930
+nú 929
+ee 926
+jo 922
+ay 920
+xi 918
+c_ 895
+má 877
+az 856
+sí 844
+eu 833
+m_ 827
+eq 803
+g_ 801
+añ 799
+úm 787
+bj 778
+aq 776
+xt 767
+ód 767
+u_ 758
+bu 724
+f_ 720
+xp 712
+h_ 710
+sh 709
+ló 680
+rn 667
+pt 663
+gn 655
+có 650
+ño 632
+x_ 624
+mm 599
+eo 592
+ás 580
+dm 577
+ña 562
+fr 561
+nl 559
+og 559
+ía 558
+ól 549
+b_ 544
+z_ 526
+ho 510
+án 498
+fl 495
+ja 494
+ró 488
+ez 483
+ún 439
+bt 437
+rl 431
+k_ 429
+rv 422
+és 420
+ya 412
+of 411
+yt 404
+_í 400
+by 397
+eñ 392
+lm 391
+ck 388
+cí 361
+_w 360
+ps 348
+gm 348
+oi 347
+só 340
+ts 339
+oq 332
+ác 329
+_á 328
+rí 327
+ug 326
+ám 324
+sm 324
+_x 322
+ár 321
+ls 317
+úl 312
+lv 311
+bs 311
+iq 308
+ff 305
+ué 300
+wa 296
+ij 293
+mó 289
+sd 287
+rb 279
+rp 277
+nz 275
+ke 272
+rq 271
+ús 269
+gl 265
+ju 264
+bú 253
+_ó 253
+ss 251
+lg 248
+_ú 241
+gú 238
+th 232
+ov 228
+bó 228
+ft 226
+ór 226
+nm 225
+hu 222
+_j 221
+ou 216
+xc 213
+bm 210
+uj 209
+mú 209
+sq 207
+dr 200
+_k 198
+ío 196
+uy 195
+uf 189
+sb 189
+tm 189
+ai 188
+tc 187
+ye 186
+fs 185
+ow 179
+rf 178
+tt 178
+gs 178
+én 177
+mé 174
+lc 170
+vu 170
+tl 170
+ná 169
+ds 163
+dv 162
+ví 161
+ér 160
+áx 158
+ld 157
+tw 156
+ak 155
+é_ 154
+cs 153
+ax 151
+gp 150
+lf 150
+át 149
+ty 147
+ét 147
+q_ 145
+xa 143
+ei 141
+tó 141
+sl 136
+úf 134
+pp 133
+w_ 132
+í_ 132
+zc 129
+yu 127
+oo 127
+tp 125
+ié 123
+té 123
+dí 123
+af 122
+ry 122
+sy 121
+wi 119
+mn 116
+_z 115
+dl 115
+rz 114
+uv 114
+uo 112
+pg 112
+wo 110
+ág 109
+íd 106
+v_ 106
+cp 106
+zo 105
+j_ 104
+we 104
+yú 104
+ix 104
+ht 103
+yo 103
+íf 101
+zó 99
+áf 99
+dp 99
+lp 98
+pá 96
+nó 96
+bc 95
+uz 95
+ey 95
+uí 94
+nj 94
+sn 92
+lq 91
+fp 91
+pd 90
+nk 90
+dd 86
+né 86
+td 83
+cd 83
+ki 82
+pú 80
+ml 80
+pk 79
+úb 79
+ze 79
+ít 78
+sk 78
+oj 77
+fd 76
+ok 76
+aú 75
+ux 75
+nr 74
+ís 73
+yp 73
+sr 73
+áq 72
+íc 72
+rk 72
+sf 71
+ae 70
+kt 69
+py 67
+oa 67
+ox 66
+hh 65
+ko 65
+ms 63
+sv 63
+lé 62
+ws 62
+ka 60
+ks 60
+zq 59
+np 59
+_é 58
+dw 57
+rj 57
+ew 56
+df 56
+íg 55
+mí 54
+vé 54
+yn 54
+ah 53
+tí 52
+aí 52
+zi 51
+ph 51
+nn 51
+hé 50
+oy 50
+kg 50
+mt 49
+dé 49
+oh 49
+uu 49
+ys 49
+ym 49
+bp 48
+gh 48
+ii 48
+tf 48
+ly 47
+fí 46
+óm 46
+úa 45
+ú_ 45
+úc 44
+xy 44
+ós 42
+gc 42
+md 41
+pó 40
+dx 40
+dt 40
+dn 40
+lá 40
+mc 39
+cá 39
+eh 39
+xm 38
+íz 38
+cé 38
+zá 37
+sw 37
+wr 36
+lz 34
+út 34
+cm 34
+dy 34
+wn 32
+db 32
+aw 31
+eí 31
+dó 31
+hd 31
+oe 31
+bf 31
+mr 31
+ek 30
+tb 30
+hs 30
+ku 30
+xe 29
+uá 29
+ny 29
+iu 28
+lb 28
+áp 27
+dc 27
+xz 26
+nq 26
+lr 26
+kf 26
+pm 26
+éx 25
+kc 25
+lx 25
+gv 25
+gz 24
+gt 24
+nb 24
+fm 23
+wd 23
+hm 23
+cf 23
+mf 23
+vm 23
+bd 23
+zm 22
+cj 22
+lú 22
+qw 22
+bá 21
+óg 21
+sg 21
+vf 21
+aa 21
+gó 21
+hú 21
+tg 20
+wh 20
+tn 20
+ré 19
+px 19
+xx 19
+dj 19
+dg 18
+xu 18
+vr 18
+hr 18
+zl 18
+fc 17
+cb 17
+cn 17
+hc 17
+xs 17
+iw 17
+tú 17
+gf 17
+ik 17
+mk 16
+kp 16
+vs 16
+yó 15
+bí 15
+_de 26990
+de_ 21090
+do_ 11559
+_no 11343
+el_ 10766
+_se 10650
+no_ 10237
+_co 10224
+os_ 9500
+_el 8861
+ón_ 8729
+es_ 8562
+_es 8559
+ión 8536
+_en 8107
+_la 7949
+se_ 7679
+ar_ 7615
+la_ 7474
+ent 7164
+con 7000
+_re 6765
+ció 6731
+en_ 6708
+ra_ 6693
+ado 6475
+_in 5953
+_pa 5734
+_un 5499
+or_ 5251
+te_ 5081
+as_ 5073
+par 5040
+to_ 5018
+da_ 4901
+est 4869
+nte 4755
+ro_ 4654
+al_ 4585
+ara 4515
+fic 4129
+tra 3960
+aci 3943
+ica 3937
+ero 3862
+_pu 3791
+com 3757
+ta_ 3755
+que 3606
+ido 3391
+er_ 3349
+un_ 3335
+des 3309
+_ca 3229
+sta 3225
+ada 3185
+_fi 3166
+str 3164
+na_ 3155
+men 3107
+era 3058
+per 3056
+ion 3050
+cio 3022
+_ar 2964
+ede 2950
+rec 2935
+_di 2901
+_pr 2892
+_si 2878
+_al 2876
+ist 2862
+_lo 2813
+cci 2792
+ida 2780
+ndo 2757
+res 2756
+on_ 2753
+lid 2748
+nto 2686
+ued 2670
+pue 2668
+esp 2659
+ien 2647
+ntr 2638
+ivo 2635
+del 2544
+and 2542
+re_ 2526
+che 2498
+lo_ 2460
+arc 2450
+los 2429
+por 2382
+_op 2381
+rad 2372
+nes 2350
+ect 2343
+ter 2328
+_a_ 2301
+one 2300
+esc 2283
+io_ 2253
+her 2224
+vo_ 2199
+_po 2173
+ue_ 2173
+cad 2164
+den 2150
+_qu 2145
+enc 2143
+ich 2143
+ont 2133
+car 2123
+rio 2077
+una 2075
+bre 2070
+rch 2053
+ali 2021
+ble 2012
+ecc 2005
+err 1966
+dos 1965
+_us 1962
+ten 1954
+ene 1953
+mit 1932
+chi 1902
+_ha 1899
+nci 1896
+hiv 1884
+tro 1881
+spe 1856
+_ex 1856
+áli 1825
+omb 1818
+_fa 1815
+vál 1812
+pro 1812
+mbr 1806
+tos 1778
+_so 1774
+ifi 1755
+dir 1750
+rma 1739
+nom 1725
+ma_ 1718
+_ti 1673
+ori 1668
+_er 1657
+ina 1651
+_va 1647
+sió 1609
+ir_ 1598
+pre 1590
+_y_ 1586
+omp 1569
+las 1568
+le_ 1557
+cia 1546
+fal 1524
+ver 1519
+po_ 1515
+rro 1515
+tor 1509
+ran 1507
+reg 1507
+tar 1504
+ire 1504
+_ma 1503
+ste 1502
+_mo 1494
+iza 1492
+act 1490
+pci 1487
+cto 1485
+sec 1478
+ura 1470
+ror 1470
+stá 1443
+int 1440
+rar 1430
+all 1413
+tad 1411
+_su 1395
+opc 1385
+for 1385
+ia_ 1384
+liz 1380
+mo_ 1370
+cac 1363
+ce_ 1360
+_o_ 1358
+so_ 1343
+orm 1337
+cer 1336
+_ta 1336
+ere 1334
+_fu 1331
+olo 1328
+it_ 1322
+_ve 1321
+tes 1314
+ato 1313
+tiv 1301
+_lí 1301
+abl 1299
+ant 1297
+ona 1296
+ser 1294
+_ac 1293
+ama 1285
+ea_ 1284
+rea 1282
+ite 1276
+_pe 1275
+cla 1274
+_ob 1270
+qui 1270
+ari 1267
+cid 1252
+nea 1252
+dor 1234
+inv 1230
+ca_ 1224
+nta 1214
+lic 1208
+_me 1204
+usa 1193
+arg 1193
+_te 1161
+val 1143
+nst 1140
+eci 1137
+ins 1132
+nal 1124
+in_ 1122
+egi 1122
+ici 1119
+mer 1113
+_li 1112
+mie 1111
+ctu 1102
+tá_ 1095
+ece 1093
+eta 1090
+rta 1086
+les 1083
+amb 1082
+ual 1075
+sin 1074
+tie 1074
+nco 1074
+cam 1064
+scr 1056
+cri 1056
+nvá 1056
+min 1055
+inc 1044
+ema 1043
+ndi 1041
+ces 1036
+erm 1032
+mpo 1031
+emp 1029
+_sa 1029
+bol 1029
+rac 1028
+git 1027
+tip 1019
+ete 1014
+pos 1007
+mbi 1006
+ecu 1003
+ne_ 999
+rmi 998
+lín 998
+íne 994
+lec 993
+_le 985
+ini 984
+odo 973
+ume 973
+ono 972
+ace 970
+lor 968
+ros 966
+ers 966
+alo 965
+uet 964
+fin 960
+end 959
+ope 956
+nti 955
+ave 944
+gis 935
+_cl 934
+pec 932
+noc 931
+dad 929
+go_ 928
+ili 925
+sal 925
+_ad 923
+mod 921
+ve_ 920
+_fo 916
+sol 913
+tab 910
+_tr 907
+til 906
+ort 906
+_cr 905
+ner 901
+esi 900
+_ra 899
+ras 898
+lav 894
+ibl 891
+deb 891
+mpl 889
+tam 888
+udo 888
+cre 887
+iva 886
+ami 874
+def 865
+das 865
+ipo 864
+oci 863
+co_ 862
+tan 861
+ubi 861
+pud 855
+uta 853
+dic 850
+igu 849
+bic 847
+omo 845
+cif 842
+mbo 838
+ert 838
+tru 837
+_gi 835
+ico 831
+ple 831
+_nú 827
+sco 827
+jet 826
+_sí 825
+aba 824
+orr 817
+rib 811
+rsi 809
+nar 807
+tua 806
+equ 796
+ebe 792
+ier 791
+sar 786
+_bi 785
+_mu 785
+núm 783
+uer 783
+ita 782
+sím 782
+ímb 782
+_cu 781
+oca 781
+onf 778
+_an 777
+cti 777
+rab 776
+an_ 774
+mas 774
+tal 774
+obj 773
+ase 769
+aqu 768
+bje 768
+imi 767
+jo_ 767
+_au 766
+_da 764
+tec 763
+be_ 761
+sca 760
+ram 758
+úme 757
+_vá 756
+ad_ 755
+ren 754
+gen 754
+tur 748
+ext 742
+lis 740
+dis 738
+cor 737
+_im 736
+rde 733
+eto 733
+lla 730
+ord 730
+reu 730
+dat 727
+zar 725
+osi 724
+_to 724
+uti 722
+lar 721
+alt 716
+va_ 716
+ria 715
+man 715
+fer 712
+ios 708
+mac 706
+ena 703
+_má 703
+exp 703
+eub 702
+paq 701
+efe 698
+uie 698
+_or 698
+ale 697
+fue 696
+_gr 696
+ade 692
+_nu 692
+_ni 692
+efi 691
+_ab 690
+ref 689
+ing 683
+nad 681
+_ej 681
+ruc 678
+art 675
+_ut 675
+nde 674
+_ap 672
+nic 670
+vis 670
+imp 667
+ore 666
+iad 663
+ha_ 663
+edi 660
+ló_ 658
+sit 657
+ues 656
+nid 655
+pri 653
+uar 653
+sa_ 650
+lta 648
+mat 647
+seg 647
+eje 647
+ucc 644
+inf 642
+lló 642
+ame 641
+zad 640
+_mi 639
+ens 639
+asi 633
+dif 629
+si_ 627
+_ce 625
+ati 624
+rgu 621
+ice 620
+tic 616
+gum 616
+jec 615
+ará 613
+mpa 612
+nfo 610
+ine 604
+egu 603
+_em 599
+aza 594
+eri 594
+ele 589
+gur 588
+exi 588
+esa 587
+oce 586
+gra 586
+nfi 582
+laz 578
+año 577
+mue 576
+ño_ 575
+ide 569
+hay 567
+ost 565
+iti 564
+mpr 560
+ora 557
+rti 557
+xis 556
+ito 556
+ay_ 556
+emo 555
+rra 555
+_ba 555
+iso 554
+lem 554
+_có 552
+unt 552
+eso 551
+uen 550
+lad 549
+dmi 549
+adm 547
+tid 542
+cte 542
+red 541
+pla 540
+ern 537
+dig 537
+eti 537
+mañ 536
+tas 536
+ear 536
+sig 535
+_ge 534
+pon 533
+tem 531
+ás_ 529
+rim 529
+ign 527
+vos 525
+ibi 524
+eco 518
+cód 518
+pli 517
+igo 517
+tod 516
+omm 512
+lee 510
+ons 510
+ala 510
+_st 509
+rre 509
+sti 507
+ódi 503
+tri 502
+ota 501
+sen 500
+gar 499
+nla 499
+rep 498
+nsa 497
+za_ 495
+enl 495
+unc 495
+cua 494
+uto 494
+_ne 494
+_av 492
+cut 490
+bor 486
+rup 485
+lim 484
+_bl 483
+dem 483
+tre 482
+ba_ 482
+oma 480
+lac 479
+eme 479
+abr 479
+bas 478
+var 477
+mar 477
+ind 476
+fec 475
+_do 474
+pat 473
+más 471
+avi 469
+sua 468
+eli 468
+mmi 468
+ía_ 467
+cab 467
+gun 463
+cal 463
+itu 461
+iar 461
+eo_ 460
+ead 460
+roc 458
+ega 458
+fig 456
+irm 456
+opo 455
+_id 455
+det 454
+rev 454
+are 452
+ese 450
+fra 450
+sia 449
+usu 449
+tin 448
+nec 448
+hac 447
+cue 447
+sub 445
+mos 444
+rit 442
+atr 440
+loc 439
+tif 438
+ima 437
+abe 437
+fir 437
+nca 437
+ias 436
+uci 436
+isp 436
+nin 432
+ile 431
+aut 431
+req 431
+lti 427
+ula 427
+eer 426
+fun 425
+pac 425
+ol_ 422
+omi 420
+rca 420
+id_ 419
+_ru 417
+bia 414
+uev 412
+can 412
+mis 412
+anc 411
+blo 411
+_as 411
+baj 410
+spa 408
+és_ 408
+rga 407
+bla 406
+nue 405
+rte 404
+ajo 404
+índ 404
+odi 402
+rda 401
+cas 400
+_ín 399
+llo 398
+let 397
+voc 396
+obt 394
+der 393
+_he 393
+_pi 393
+erv 391
+cta 391
+sis 391
+rop 388
+_vi 387
+xpr 385
+yte 380
+son 380
+byt 379
+nor 379
+evo 379
+ial 379
+spo 375
+rel 371
+aje 366
+ime 365
+bra 365
+gui 364
+_fr 364
+obr 362
+lin 362
+rut 361
+gru 360
+alm 359
+sob 359
+eno 359
+mal 358
+sto 358
+nda 357
+dar 357
+_bo 357
+ún_ 355
+_by 354
+bio 353
+dia 352
+bri 351
+ecl 349
+upo 346
+rno 346
+rid 345
+eni 345
+ulo 344
+ult 344
+med 343
+ech 342
+ogr 342
+_at 342
+oni 341
+eña 340
+iem 339
+sim 339
+ya_ 338
+_ll 337
+tex 336
+rem 335
+señ 333
+lam 333
+uso 331
+opi 331
+apl 331
+spl 329
+ll_ 329
+rir 329
+_ig 329
+dep 328
+loq 327
+ólo 327
+ral 325
+oin 325
+vid 325
+gme 324
+ote 323
+mad 323
+bir 322
+use 321
+zam 321
+ana 321
+_ya 321
+pen 320
+_só 320
+sól 320
+tán 318
+ell 318
+lan 317
+cha 316
+fil 315
+uan 314
+áct 314
+col 314
+je_ 312
+últ 309
+ími 309
+met 309
+coi 308
+me_ 308
+gno 308
+lím 307
+sel 307
+evi 306
+ibu 306
+iqu 305
+bit 302
+sio 302
+nos 302
+ola 302
+_eq 302
+_sh 302
+ian 301
+ela 300
+gua 299
+dul 298
+etr 298
+_ár 297
+sh_ 297
+ata 296
+bli 296
+ang 294
+rse 294
+usi 294
+tró 293
+eda 291
+ron 291
+age 291
+eas 290
+bte 289
+oqu 287
+_bu 286
+saj 286
+sib 286
+ts_ 285
+_ag 285
+ate 285
+_et 285
+lon 284
+spu 284
+sac 284
+sop 283
+rog 282
+xte 281
+mem 280
+isi 279
+ila 279
+rvi 278
+erd 278
+xto 277
+_fl 277
+cen 277
+vac 276
+gre 276
+amp 275
+mpi 274
+epo 274
+rón 274
+ret 274
+pil 273
+abi 273
+ond 273
+und 272
+mor 272
+olu 272
+tim 271
+pun 271
+iab 270
+oto 269
+uiv 269
+ría 267
+apa 267
+nsi 266
+rob 266
+su_ 266
+cop 266
+duc 265
+sad 265
+ars 265
+tiq 265
+ber 264
+rqu 264
+did 262
+rác 262
+dec 262
+_n_ 261
+imo 259
+odu 259
+ga_ 259
+án_ 259
+uel 259
+bin 259
+clu 258
+_lu 256
+rip 256
+ree 256
+ngo 255
+fus 255
+_bú 253
+_hi 253
+sde 253
+ijo 252
+mot 251
+esd 250
+san 249
+eva 249
+pia 249
+mód 248
+ódu 248
+acc 248
+_ci 248
+ngu 246
+aus 246
+rbo 246
+_du 245
+ací 244
+uit 244
+war 243
+oba 243
+tir 243
+otr 242
+lve 241
+ino 241
+rá_ 240
+cap 240
+leg 240
+et_ 240
+_ot 239
+hel 239
+nen 238
+din 236
+eza 235
+lug 233
+rso 233
+ch_ 233
+lme 232
+ior 232
+ced 232
+rol 232
+gún 232
+árb 232
+nza 231
+eal 231
+not 230
+sos 229
+vad 229
+tac 229
+_pl 229
+rna 228
+tib 228
+cur 228
+_om 227
+_fe 227
+ués 226
+rl_ 226
+vor 225
+ast 223
+ong 223
+uga 223
+óli 221
+aña 221
+sup 220
+imb 220
+zac 220
+mic 220
+rod 219
+pal 219
+epa 218
+mbó 218
+ból 218
+nac 217
+adi 216
+ió_ 216
+rig 216
+agr 216
+fij 215
+ña_ 215
+pué 213
+eja 213
+ard 213
+epe 213
+ánd 212
+eca 212
+cie 212
+_ch 212
+ive 211
+smo 211
+uni 211
+_s_ 211
+its 210
+órd 210
+_gu 210
+ocu 209
+lat 209
+exc 208
+rci 208
+gin 207
+lea 207
+ill 206
+_ór 206
+ngú 206
+ano 206
+squ 205
+iat 205
+ism 205
+cos 204
+num 204
+nme 203
+am_ 202
+nib 201
+alg 201
+_ur 201
+tag 201
+ute 200
+pt_ 200
+mag 200
+orc 199
+cod 198
+nse 198
+sum 198
+nam 198
+esu 197
+tud 196
+ane 196
+arq 196
+bie 195
+ncl 195
+ric 195
+avo 195
+st_ 194
+nas 194
+erí 194
+inm 194
+bib 194
+ró_ 194
+ves 194
+mon 193
+cul 193
+ff_ 193
+bez 192
+uno 191
+ngi 190
+lit 189
+ud_ 189
+rat 189
+zan 189
+ñad 189
+eam 189
+ipt 188
+ee_ 187
+ipl 187
+ani 186
+upe 186
+ubm 186
+mul 185
+ict 185
+cum 185
+he_ 185
+ez_ 184
+sam 183
+sof 183
+uri 183
+tom 182
+pto 181
+cho 181
+ge_ 180
+_ho 180
+oda 180
+fav 180
+urs 180
+oft 179
+az_ 179
+len 178
+is_ 177
+sep 177
+_tu 177
+dev 177
+bso 177
+_x_ 177
+lob 177
+nve 176
+obl 176
+bil 176
+log 176
+lio 176
+inu 175
+has 175
+at_ 175
+die 174
+cío 174
+sic 173
+vel 173
+she 173
+eba 172
+_ed 171
+may 171
+rot 171
+ash 171
+gad 170
+_añ 170
+jun 170
+gal 169
+gna 169
+ome 168
+iot 168
+ls_ 168
+but 168
+ng_ 168
+rag 168
+vim 168
+nfl 167
+pe_ 167
+il_ 166
+lle 166
+via 166
+vue 166
+_na 166
+rin 166
+uir 165
+fli 165
+agm 165
+bus 164
+ío_ 164
+olv 164
+im_ 164
+ven 163
+han 163
+ack 163
+ole 163
+_il 163
+doc 163
+tat 162
+xim 161
+dam 161
+nch 161
+lab 161
+ifr 161
+lt_ 161
+onv 160
+suf 160
+niv 160
+_mú 160
+flo 159
+máx 158
+pid 158
+asa 157
+vol 157
+múl 157
+itm 157
+bmó 157
+ust 156
+dio 156
+_ub 156
+aso 156
+egm 156
+sid 154
+ho_ 154
+iná 154
+rru 153
+rám 153
+áme 153
+upl 153
+ilo 152
+ufi 152
+anz 151
+pie 151
+cep 151
+ja_ 151
+nd_ 151
+dit 151
+isa 151
+_úl 150
+leo 150
+esb 150
+cce 150
+nám 150
+ámi 150
+áxi 149
+ig_ 149
+erc 149
+pur 149
+ncu 149
+lte 148
+nua 148
+twa 148
+nce 148
+sul 148
+pc_ 147
+ftw 147
+us_ 147
+nan 146
+mbl 146
+lib 145
+uid 145
+arr 145
+elo 145
+_sc 144
+vez 144
+sea 144
+lia 144
+mir 143
+aro 143
+ibe 143
+bec 143
+lma 143
+pul 143
+ife 143
+epu 143
+pet 142
+_ro 142
+iff 142
+onc 141
+rto 141
+hea 141
+rif 140
+fo_ 140
+áti 139
+_am 139
+cit 139
+pas 138
+cro 138
+lot 138
+usc 137
+url 137
+map 137
+dup 137
+nat 135
+uda 135
+ed_ 135
+rd_ 135
+xce 134
+hor 134
+ach 134
+pan 134
+búf 134
+ujo 133
+nej 133
+exa 133
+ck_ 133
+nt_ 133
+soc 133
+xpo 133
+ut_ 133
+úfe 133
+ilt 132
+erp 132
+set 132
+elv 132
+ct_ 132
+adu 131
+rró 131
+_oc 130
+ove 130
+tch 130
+xtr 129
+_ps 129
+nir 128
+ape 128
+_pc 128
+erf 127
+ode 127
+ltr 126
+ayu 126
+sus 126
+buc 126
+udi 126
+_ay 125
+yud 125
+cuc 125
+ef_ 125
+_d_ 125
+bi_ 125
+én_ 124
+asu 124
+ben 123
+eve 123
+_br 123
+bar 123
+reb 122
+ap_ 122
+sbo 122
+rom 122
+ñal 121
+ién 121
+lto 121
+_b_ 121
+hec 120
+out 120
+_sp 119
+rva 119
+tio 119
+hab 119
+ige 119
+abs 119
+_gl 119
+pta 118
+cke 118
+reo 117
+ept 117
+rlo 117
+bús 116
+uro 116
+neg 116
+evu 116
+igi 116
+tu_ 116
+úsq 115
+ejo 115
+rue 115
+tig 115
+_hu 115
+_ju 115
+jar 115
+rt_ 115
+jos 114
+óne 114
+edo 114
+ex_ 114
+tax 113
+emb 113
+ry_ 113
+epr 113
+isc 112
+nul 112
+ib_ 112
+tuv 112
+enz 112
+rgo 112
+mil 111
+sor 111
+ses 111
+nex 111
+ans 111
+glo 111
+lgo 111
+lf_ 111
+cle 110
+gul 110
+ean 110
+aja 110
+rpr 110
+rza 109
+apt 109
+env 109
+_ev 109
+uye 109
+ic_ 109
+rc_ 108
+sha 108
+_gp 108
+axi 107
+bid 107
+ego 107
+ivi 107
+vie 107
+_ef 107
+ni_ 107
+ock 107
+anu 107
+sp_ 107
+ps_ 106
+rán 106
+mov 105
+mát 105
+ban 105
+wor 105
+run 105
+adv 105
+got 104
+nvi 104
+off 104
+ayú 104
+yús 104
+lca 103
+riz 103
+bal 103
+_c_ 103
+dur 102
+gor 102
+uvo 102
+toc 102
+elf 102
+upt 101
+tub 101
+ebi 101
+ífi 101
+arl 101
+_gn 101
+app 101
+ec_ 101
+pu_ 101
+mif 101
+ees 100
+ecí 100
+cíf 100
+plo 100
+gs_ 100
+teg 99
+gnu 99
+op_ 99
+scu 99
+mét 99
+óni 99
+lum 99
+hil 98
+ein 98
+gan 98
+dan 98
+cib 98
+pst 98
+jad 97
+uea 97
+lut 97
+lus 97
+don 97
+opt 97
+tls 97
+omá 96
+bac 96
+pod 96
+btu 96
+uct 96
+erá 96
+pse 96
+cir 95
+tot 95
+nim 95
+bié 95
+_pá 95
+_cp 95
+flu 94
+gat 94
+fia 94
+oli 94
+pru 93
+rei 93
+tó_ 93
+obs 93
+vio 93
+tmo 93
+ag_ 93
+ági 93
+_ss 93
+har 92
+ot_ 92
+bro 92
+có_ 92
+idi 92
+_vo 92
+ak_ 92
+ns_ 92
+alc 91
+lqu 91
+_mó 91
+abo 91
+rdo 91
+_up 91
+vas 91
+eem 91
+eac 91
+neo 91
+ix_ 91
+big 90
+gid 90
+nis 90
+emi 90
+nit 90
+ráf 90
+áfi 90
+éri 90
+ss_ 90
+pin 90
+_wi 89
+grá 89
+_dí 89
+rge 89
+alq 88
+xió 88
+rfa 88
+vía 88
+paz 88
+gue 87
+zca 87
+luy 87
+fre 87
+sie 87
+_tl 87
+oc_ 87
+stu 87
+rm_ 86
+aud 86
+sem 86
+mpe 86
+om_ 86
+etc 86
+dve 86
+ket 86
+uac 85
+guo 85
+ri_ 85
+cat 84
+nu_ 84
+ncr 84
+nga 84
+úsc 84
+esh 84
+pág 84
+mid 83
+oso 83
+div 83
+uin 82
+vec 82
+ueb 82
+orn 82
+eng 82
+_is 82
+cía 82
+atu 82
+luc 81
+tit 81
+rtu 81
+ail 81
+th_ 81
+_wo 81
+onj 80
+mpu 80
+win 80
+_ds 80
+clo 80
+ip_ 79
+púb 79
+úbl 79
+dow 79
+_ir 79
+_sy 79
+ty_ 79
+elt 78
+ibr 78
+_pú 78
+ds_ 78
+siv 78
+nju 78
+erg 78
+og_ 78
+um_ 77
+yen 77
+ob_ 77
+lig 76
+bos 76
+lui 76
+nva 76
+jes 75
+_aú 75
+aún 75
+nsu 75
+lgu 75
+oco 75
+umé 75
+mér 75
+_ct 75
+luj 74
+his 74
+rme 74
+esq 74
+_fs 74
+rs_ 74
+eck 74
+_e_ 73
+cel 73
+ye_ 73
+xcl 73
+éti 73
+cpu 73
+gla 73
+_fp 73
+nsn 73
+fet 73
+arm 72
+máq 72
+áqu 72
+riv 72
+lie 72
+_ví 72
+_ht 72
+uem 72
+ctr 72
+cim 71
+ube 71
+uo_ 71
+icó 71
+egl 71
+_p_ 70
+add 70
+zo_ 70
+quí 70
+bun 70
+tp_ 70
+rri 70
+ath 70
+efs 70
+icc 69
+ntu 69
+sma 69
+pic 69
+seu 69
+eud 69
+elp 69
+_wa 69
+_q_ 69
+uce 68
+orq 68
+nio 68
+orz 68
+duz 68
+uzc 68
+gos 68
+_cd 68
+ise 67
+zab 67
+zó_ 67
+ago 67
+faz 67
+_th 67
+umb 67
+sl_ 67
+ngl 66
+nip 66
+ipu 66
+ied 66
+rce 66
+ml_ 66
+mun 66
+xpa 66
+ayo 66
+pti 66
+_gs 66
+iet 65
+uma 65
+ús_ 65
+wer 64
+emá 64
+mp_ 64
+arp 64
+ías 64
+top 64
+std 64
+vic 63
+nie 63
+bió 63
+fla 63
+nc_ 63
+_aq 63
+umn 63
+ueo 62
+ipc 62
+ie_ 62
+_ga 62
+líc 62
+deo 62
+fon 62
+_mé 62
+ler 62
+ira 62
+hij 62
+yor 62
+_t_ 62
+plt 62
+gp_ 62
+rof 61
+pa_ 61
+tel 61
+omu 61
+ws_ 61
+hum 61
+typ 61
+éto 61
+ubc 61
+ush 61
+hex 61
+mna 61
+rsa 60
+rov 60
+_ún 60
+úni 60
+plí 60
+íci 60
+apu 60
+ype 60
+rg_ 60
+lse 60
+pl_ 60
+ags 60
+sil 59
+van 59
+ueñ 59
+pol 59
+cip 59
+cs_ 59
+acr 59
+irt 59
+rie 59
+rej 59
+rus 59
+dex 59
+_iz 59
+izq 59
+laj 59
+crl 59
+egr 58
+upr 58
+inú 58
+lp_ 58
+_of 58
+ecr 58
+nif 58
+vir 58
+tác 58
+_u_ 58
+ezc 58
+_dp 58
+haz 58
+zqu 58
+nk_ 58
+tus 58
+mak 58
+lli 57
+rpe 57
+ngr 57
+íde 57
+htt 57
+ttp 57
+sci 57
+qué 57
+ué_ 57
+une 57
+sun 57
+non 56
+aur 56
+sí_ 56
+tér 56
+_ic 56
+_ke 56
+arj 56
+ntá 56
+dav 56
+_ld 56
+_go 56
+ows 56
+oné 56
+jus 55
+lés 55
+cup 55
+uca 55
+pad 55
+get 55
+ueg 55
+rje 55
+sue 55
+ua_ 55
+fie 55
+pg_ 55
+ow_ 55
+uí_ 55
+gpg 55
+ssl 55
+glé 54
+ain 54
+rav 54
+sho 54
+iac 54
+ft_ 54
+pel 54
+ink 54
+peq 53
+raf 53
+víd 53
+íst 53
+umi 53
+aco 53
+xad 53
+tdi 53
+nre 53
+fs_ 53
+puj 53
+_dv 53
+_aj 52
+aju 52
+if_ 52
+eño 52
+tau 52
+lvi 52
+ges 52
+ump 52
+aca 52
+izó 52
+efa 52
+uls 52
+hos 52
+ubs 52
+ege 52
+bs_ 52
+aul 52
+_it 51
+mín 51
+iba 51
+tai 51
+cin 51
+ups 51
+net 51
+rap 51
+cko 51
+ofu 50
+mib 50
+xit 50
+ché 50
+hé_ 50
+ipa 50
+uch 50
+tho 50
+pkg 50
+asc 50
+pus 50
+rco 50
+rry 50
+bis 50
+rak 50
+osa 49
+vam 49
+_vu 49
+erl 49
+als 49
+nté 49
+rui 49
+rla 49
+ose 49
+duj 49
+ned 49
+ull 49
+_be 49
+aví 49
+cc_ 49
+mún 49
+ips 49
+fau 49
+kou 49
+gri 48
+dwa 48
+íti 48
+taj 48
+rpo 48
+rfi 48
+uió 48
+dle 48
+ley 48
+díg 48
+pr_ 48
+lda 48
+sn_ 48
+eg_ 48
+dob 48
+cub 48
+_mm 48
+lcu 48
+enr 48
+rae 48
+ubu 48
+ook 48
+vió 47
+iga 47
+mai 47
+gib 47
+enu 47
+ork 47
+mes 47
+gni 47
+lej 47
+oja 47
+sur 47
+dim 47
+_dl 47
+trl 47
+_sm 47
+omú 47
+_ja 47
+ovi 46
+xac 46
+nvo 46
+rox 46
+uad 46
+xt_ 46
+nó_ 46
+gió 46
+raí 46
+inó 46
+pir 46
+iz_ 46
+_cs 46
+ti_ 46
+gex 46
+ake 46
+bcl 46
+_mí 45
+acu 45
+pag 45
+uiz 45
+vit 45
+sté 45
+fd_ 45
+pes 45
+lga 45
+ucl 45
+adr 45
+ess 45
+mip 45
+ux_ 45
+ule 45
+oq_ 45
+núc 44
+úcl 44
+enú 44
+ude 44
+tuc 44
+lel 44
+jem 44
+ids 44
+nús 44
+kg_ 44
+_ó_ 44
+_ee 44
+áre 44
+mb_ 44
+hib 44
+dvo 44
+zip 43
+íni 43
+_ui 43
+_ah 43
+emu 43
+tog 43
+rne 43
+día 43
+_fd 43
+eye 43
+iri 43
+rás 43
+cis 43
+ubr 43
+dd_ 43
+nét 43
+cau 42
+ños 42
+oxy 42
+xy_ 42
+lir 42
+gia 42
+uje 42
+put 42
+dpk 42
+mez 42
+zcl 42
+mmo 42
+zer 42
+ul_ 42
+ffi 42
+ocs 42
+aya 41
+erz 41
+eb_ 41
+edu 41
+ej_ 41
+nso 41
+jan 41
+pps 41
+icr 41
+thu 41
+xpi 41
+suc 41
+trá 41
+aux 41
+sym 41
+esv 41
+aga 40
+bui 40
+gic 40
+vés 40
+esk 40
+lue 40
+ogi 40
+miz 40
+bad 40
+hhh 40
+dre 40
+chu 40
+ks_ 40
+atc 40
+diz 40
+csp 40
+hue 39
+gio 39
+afo 39
+key 39
+nú_ 39
+avé 39
+irr 39
+epc 39
+ger 39
+irs 39
+ígi 39
+ub_ 39
+_f_ 39
+fp_ 39
+apo 39
+ald 39
+uu_ 39
+owe 38
+sc_ 38
+gas 38
+úa_ 38
+upa 38
+ija 38
+aho 38
+ubl 38
+_ty 38
+tás 38
+skt 38
+kto 38
+ii_ 38
+pio 38
+aíz 38
+íz_ 38
+opy 38
+azo 38
+itt 38
+_bs 38
+tma 38
+em_ 37
+ptu 37
+gir 37`,
+		wordTotal: 238765,
+		words: `
+de 18029
+no 9268
+el 8126
+se 6675
+la 6535
+en 5014
+para 3720
+un 3270
+del 2387
+a 2301
+es 2163
+puede 2154
+con 2048
+los 1925
+una 1896
+al 1881
+que 1786
+fichero 1692
+y 1586
+archivo 1420
+o 1358
+error 1351
+por 1263
+nombre 1263
+las 1113
+está 1094
+sección 990
+como 818
+sin 806
+pudo 801
+tipo 769
+línea 751
+valor 739
+git 730
+opción 726
+número 685
+inválido 684
+registro 664
+ser 660
+falló 642
+entrada 633
+si 616
+salida 616
+opciones 598
+este 594
+directorio 586
+versión 580
+clave 573
+modo 562
+debe 548
+hay 542
+datos 523
+tiene 520
+símbolo 519
+tamaño 508
+ha 489
+muestra 476
+código 473
+usar 472
+lista 468
+reubicación 451
+formato 449
+más 435
+información 415
+ficheros 408
+leer 408
+argumento 396
+archivos 395
+objeto 395
+válido 390
+usuario 384
+índice 384
+crear 380
+desconocido 378
+pero 377
+operando 376
+esta 375
+orden 374
+instrucción 372
+aviso 372
+paquete 371
+commit 370
+fuera 369
+solo 334
+mostrar 328
+existe 326
+admite 322
+sólo 319
+estado 318
+cadena 313
+usa 313
+expresión 313
+inválida 312
+esperaba 311
+ya 306
+fallo 302
+demasiado 300
+válida 297
+pueden 296
+dirección 295
+abrir 289
+falta 287
+grupo 285
+nombres 284
+límite 281
+cuando 274
+sistema 273
+su 265
+n 261
+trabajo 260
+símbolos 260
+son 259
+ruta 259
+rama 259
+requiere 257
+base 257
+carácter 255
+uso 251
+desde 250
+necesita 248
+obtener 243
+bytes 240
+líneas 240
+todos 238
+memoria 236
+actual 233
+cada 228
+escribir 227
+rango 227
+lo 225
+hacer 224
+desplazamiento 222
+caracteres 221
+encontrar 221
+función 220
+mensaje 220
+instrucciones 220
+id 218
+argumentos 217
+paquetes 216
+después 213
+árbol 213
+s 211
+lugar 209
+certificado 209
+defecto 206
+ningún 206
+servidor 206
+firma 205
+órdenes 204
+cambios 203
+establecer 202
+antes 201
+dentro 200
+tiempo 199
+campo 199
+etiqueta 198
+descarta 194
+objetos 194
+interno 193
+configuración 192
+genera 192
+final 191
+programa 191
+cero 190
+nuevo 190
+contiene 190
+enlace 189
+sobre 187
+utiliza 186
+referencia 183
+contraseña 181
+favor 178
+imagen 177
+x 177
+ejecutar 176
+variable 175
+establece 175
+repositorio 174
+desconocida 174
+posible 173
+arquitectura 170
+grande 170
+claves 168
+registros 167
+cambiar 166
+lectura 165
+permite 165
+local 163
+longitud 162
+estándar 162
+menos 161
+tabla 161
+dos 158
+mismo 158
+shell 157
+encontró 155
+coma 155
+encontrado 153
+vacío 151
+nueva 150
+ausente 149
+nivel 147
+equivocado 147
+usando 146
+destino 146
+contenido 146
+fragmento 146
+valores 145
+entre 145
+software 145
+proceso 145
+vez 144
+fuente 144
+documento 143
+espacio 143
+directiva 143
+elemento 142
+tras 142
+especificado 140
+inmediato 140
+texto 138
+control 138
+borrar 137
+teclas 137
+objetivo 137
+ninguna 136
+crea 134
+especificar 133
+comando 133
+cuenta 132
+marca 132
+remoto 130
+disponible 128
+pila 128
+contexto 127
+inesperado 127
+especifica 125
+simbólico 125
+fecha 125
+d 125
+fusión 125
+segmento 124
+submódulo 124
+secciones 123
+directorios 122
+constante 122
+biblioteca 121
+b 121
+búfer 121
+están 119
+eliminar 119
+ilegal 118
+ayuda 117
+bit 117
+temporal 117
+bits 116
+componente 116
+mal 116
+head 115
+cabecera 114
+ejecución 114
+flotante 114
+han 113
+esto 113
+ejecuta 113
+entradas 112
+parche 111
+vim 111
+forma 110
+todas 110
+patrón 110
+búsqueda 109
+primer 109
+actualizar 108
+seguridad 108
+byte 107
+máximo 106
+coincide 106
+errores 105
+escritura 105
+empleo 105
+aplicar 105
+sintaxis 104
+url 104
+mientras 104
+diff 104
+estar 103
+siguientes 103
+reconocido 103
+c 103
+operandos 103
+solamente 102
+operacional 102
+múltiples 101
+devuelve 101
+autenticarse 100
+analizar 100
+operación 99
+deben 99
+demasiados 98
+ventana 98
+abi 98
+llamada 97
+elf 96
+atención 95
+pc 95
+fin 94
+predeterminado 94
+definir 94
+parámetro 94
+depuración 94
+utilice 94
+emplea 94
+señal 93
+atributo 93
+igual 93
+bloque 92
+también 92
+hace 91
+utilizar 91
+otro 90
+obtuvo 89
+esperado 89
+desbordamiento 89
+sea 88
+ni 88
+tecla 88
+conexión 87
+incapaz 87
+cambia 86
+punto 86
+parte 86
+identificador 85
+origen 85
+commits 85
+regular 84
+distribución 84
+cifrado 84
+use 83
+reubicaciones 83
+rebase 83
+incorrecto 82
+definido 82
+largo 82
+sido 82
+sufijo 81
+cualquier 81
+acceso 81
+extensión 81
+aplicación 80
+bien 80
+listar 80
+posición 79
+espacios 79
+tener 79
+nota 79
+contra 79
+encabezado 79
+agregar 79
+inicio 78
+enlaces 78
+cambio 78
+permitido 78
+segundo 78
+lee 77
+firmas 77
+nada 77
+referencias 77
+advertencia 77
+activa 77
+soportado 77
+conflicto 77
+uno 76
+cadenas 76
+tal 76
+página 76
+aún 75
+cerrar 75
+inesperada 75
+terminal 75
+gnu 75
+debería 75
+definición 75
+encabezamiento 75
+último 74
+termina 74
+desactiva 74
+soporte 74
+resolver 74
+tls 74
+e 73
+llave 73
+compatible 73
+conjunto 73
+algoritmo 73
+script 73
+entero 72
+reconoce 72
+copia 72
+dispositivo 72
+palabra 72
+cpu 72
+stage 72
+ninguno 71
+correcto 71
+comandos 71
+fallado 71
+secuencia 71
+espera 71
+compresión 70
+p 70
+permitir 70
+prefijo 70
+signo 70
+configurar 70
+locales 70
+blanco 70
+equivocada 70
+fetch 70
+última 69
+ubicación 69
+editar 69
+atributos 69
+ramificación 69
+q 69
+cargar 68
+introduzca 68
+pública 68
+números 68
+ejecutable 68
+global 68
+buscar 67
+dependencias 67
+interfaz 67
+superior 67
+requerido 67
+soporta 67
+porque 67
+tu 67
+máquina 66
+flujo 66
+auto 66
+usado 66
+apt 66
+escrito 66
+erróneo 66
+pid 66
+invalida 66
+config 66
+total 65
+borra 65
+hasta 65
+conflictos 65
+binario 65
+direcciones 65
+disco 65
+muertas 65
+mensajes 64
+alias 64
+disponibles 64
+miembro 64
+especificación 64
+tag 64
+realizar 64
+socket 64
+rutas 64
+enlazador 64
+stash 64
+metadatos 63
+añadir 63
+fue 63
+vacía 63
+descriptor 63
+estilo 63
+codificación 63
+omisión 62
+ancho 62
+problema 62
+operador 62
+respuesta 62
+pin 62
+t 62
+stat 62
+ref 62
+mayús 62
+escribe 61
+simbólicos 61
+clase 61
+confianza 61
+filtro 60
+coincidencia 60
+dado 60
+trata 60
+otra 60
+instalado 60
+uri 60
+variables 60
+reubicante 60
+fusionar 60
+pseudo 60
+corrupto 59
+seccional 59
+permisos 58
+revisión 58
+parámetros 58
+audio 58
+caso 58
+desconoce 58
+u 58
+esquema 58
+método 58
+imprimir 58
+intercambio 58
+correo 57
+iniciar 57
+actualización 57
+entorno 57
+qué 57
+generar 57
+blob 57
+sesión 56
+módulo 56
+utilizado 56
+quiere 56
+permiten 56
+relativa 56
+hash 56
+plt 56
+copiar 56
+direccionamiento 56
+define 55
+versiones 55
+carga 55
+palabras 55
+propiedad 55
+determinar 55
+unidad 55
+patrones 55
+isa 55
+alineamiento 55
+windows 55
+durante 54
+inglés 54
+instalar 54
+pantalla 54
+fuentes 54
+comprobación 53
+segundos 53
+especificó 53
+letra 53
+tarjeta 53
+seleccionar 53
+convertir 53
+recurso 53
+proporciona 52
+actualizaciones 52
+par 52
+etiquetas 52
+plantilla 52
+mayor 52
+help 52
+aquí 52
+válidos 51
+normal 51
+cual 51
+verdadero 51
+encontrada 51
+protocolo 51
+elimina 51
+siguiente 51
+asignar 51
+procesos 51
+reconocida 51
+renombrar 51
+cache 51
+continuación 50
+tubería 50
+incompatible 50
+esperando 50
+especial 50
+elementos 50
+simple 50
+varios 50
+upstream 50
+escape 50
+interna 50
+file 50
+ignorando 50
+frase 50
+cherry 50
+crl 50
+archivador 50
+propietario 49
+acción 49
+caché 49
+contener 49
+actualmente 49
+todavía 49
+encuentra 49
+dinámica 49
+ignorar 49
+inicial 49
+duplicado 49
+tar 49
+pack 49
+larga 48
+diferente 48
+vídeo 48
+guión 48
+puntos 48
+diferentes 48
+numérico 48
+compartido 48
+puntero 48
+contenidos 48
+enlazado 48
+ensamblador 48
+checkout 48
+especificada 47
+antiguo 47
+incorrecta 47
+utilizando 47
+absoluta 47
+asume 47
+forzar 47
+otros 47
+http 47
+guardar 47
+core 47
+hijo 47
+manejar 47
+insn 47
+ctrl 47
+ubuntu 47
+ramas 47
+sun 47
+endian 46
+terminar 46
+debido 46
+resumen 46
+todo 46
+seleccionado 46
+siempre 46
+produjo 46
+podido 46
+donde 46
+condicional 46
+sintáctico 46
+bloques 45
+descripción 45
+libre 45
+específicas 45
+presente 45
+existente 45
+reubicado 45
+sentido 45
+gpg 45
+bloq 45
+bloqueo 44
+bajo 44
+admiten 44
+marcas 44
+conversión 44
+campos 44
+ó 44
+común 44
+múltiplo 44
+alt 44
+región 44
+diccionario 43
+literal 43
+arm 43
+omite 43
+carpeta 43
+bibliotecas 43
+acepta 43
+grupos 43
+real 43
+efectuar 43
+problemas 43
+primero 43
+asignación 43
+macro 43
+importación 43
+será 43
+área 43
+got 43
+agotada 43
+basura 43
+disparadores 43
+configurado 43
+branch 43
+dvorak 43
+filtros 42
+hilos 42
+licencia 42
+hora 42
+selección 42
+funciones 42
+creado 42
+general 42
+tipos 42
+creación 42
+original 42
+le 42
+dpkg 42
+verificar 42
+matriz 42
+resultado 42
+cambiado 42
+apunta 42
+word 42
+notas 42
+progreso 42
+ssl 42
+refs 42
+máxima 41
+procesador 41
+proxy 41
+activado 41
+obsoleto 41
+serán 41
+admitido 41
+alineación 41
+default 41
+tags 41
+certificados 41
+big 40
+verificación 40
+sus 40
+salir 40
+ejecutando 40
+ver 40
+ambos 40
+appstream 40
+establecido 40
+compatibilidad 40
+menor 40
+info 40
+pic 40
+dinámico 40
+revocación 40
+ocsp 40
+cantidad 39
+sí 39
+medio 39
+menú 39
+usuarios 39
+gráfica 39
+primera 39
+teclado 39
+merge 39
+añade 39
+list 39
+selecciona 39
+f 39
+soportada 39
+avisa 39
+descartar 39
+firmar 39
+realmente 39
+hacia 38
+fuerza 38
+pequeño 38
+ej 38
+errónea 38
+incluso 38
+combinación 38
+definida 38
+principal 38
+través 38
+remote 38
+raíz 38
+utilizada 38
+toma 38
+debian 38
+historia 38
+despl 38
+add 38
+núcleo 38
+demasiadas 38
+inválidos 38
+saltar 38
+columnas 38
+string 38
+veces 37
+salta 37
+encontraron 37
+restaurar 37
+descargar 37
+detalles 37
+uid 37
+distinto 37
+ids 37
+minúsculas 37
+almacena 37
+dir 37
+comunes 37
+arg 37
+negativo 37
+enviar 37
+llamadas 37
+anchura 37
+dinámicas 37
+compensación 37
+multi 37
+estás 37
+otras 36
+funciona 36
+autenticación 36
+contador 36
+estos 36
+firmado 36
+listado 36
+anterior 36
+ahora 36
+tienen 36
+formado 36
+color 36
+cómo 36
+intérprete 36
+sobreescribir 36
+completado 36
+recursos 36
+exportación 36
+único 36
+thumb 36
+duplicada 36
+alternativo 36
+incluir 36
+dispersión 36
+push 36
+fonético 36
+procesar 35
+consola 35
+continuar 35
+binarios 35
+absoluto 35
+informe 35
+plano 35
+utilizó 35
+módulos 35
+manipular 35
+modificar 35
+agrega 35
+bucle 35
+combinar 35
+hoja 35
+frente 35
+deshacer 35
+bisect 35
+bitmap 35
+subclave 35
+alemán 35
+hilo 34
+escriba 34
+servicio 34
+automáticamente 34
+devolvió 34
+ejemplo 34
+set 34
+comenzar 34
+automática 34
+izquierda 34
+r 34
+derecho 34
+marco 34
+intenta 34
+compilado 34
+g 34
+dependencia 34
+manejo 34
+stdin 34
+emisor 34
+macintosh 34
+mib 33
+acceder 33
+virtual 33
+esté 33
+desktop 33
+incompatibles 33
+imposible 33
+trabajos 33
+sustitución 33
+indica 33
+condición 33
+aplica 33
+mayúsculas 33
+petición 33
+extendido 33
+luego 33
+diferencias 33
+submódulos 33
+ensambla 33
+puedes 33
+francés 33
+predefinido 32
+parece 32
+descarga 32
+huella 32
+administrador 32
+personal 32
+algunos 32
+formatos 32
+comportamiento 32
+muy 32
+registrado 32
+leyendo 32
+escribiendo 32
+da 32
+barra 32
+posix 32
+adición 32
+expr 32
+m 32
+enlaza 32
+little 32
+modelo 32
+mover 32
+extraer 32
+fsmonitor 32
+nulo 31
+comprimido 31
+acuerdo 31
+intentar 31
+necesario 31
+nunca 31
+actualizado 31
+gid 31
+montaje 31
+captura 31
+específico 31
+prioridad 31
+contenedor 31
+omitiendo 31
+exactamente 31
+va 31
+exportar 31
+estructura 31
+incluye 31
+resolución 31
+compartida 31
+mips 31
+detectado 31
+notes 31
+ruso 31
+núm 30
+creando 30
+red 30
+podría 30
+cierre 30
+extra 30
+quizá 30
+componentes 30
+misma 30
+activar 30
+antigua 30
+externo 30
+obsoleta 30
+modos 30
+conectar 30
+sigue 30
+cd 30
+unix 30
+sola 30
+precisión 30
+previo 30
+borrado 30
+importar 30
+indefinido 30
+direccional 30
+objetivos 30
+previa 30
+extendida 30
+relativo 30
+insertar 30
+respaldo 30
+seguimiento 30
+secreta 30
+preferencias 30
+comas 29
+alternativa 29
+relleno 29
+inicializar 29
+pudieron 29
+completar 29
+metainfo 29
+almacenamiento 29
+comprobar 29
+proporcionado 29
+varias 29
+excepción 29
+algo 29
+ascii 29
+busca 29
+modificación 29
+comentario 29
+atrás 29
+efecto 29
+intentó 29
+tam 29
+independiente 29
+z 29
+aplicado 29
+dañado 29
+concuerda 29
+sparse 29
+ssh 29
+útil 28
+requieren 28
+momento 28
+suma 28
+instalación 28
+reiniciar 28
+autor 28
+desactivar 28
+equipo 28
+buscando 28
+recibió 28
+completa 28
+ilegales 28
+predeterminada 28
+adicional 28
+comienza 28
+mantener 28
+remota 28
+pareja 28
+tanto 28
+generación 28
+hexadecimal 28
+salto 28
+toc 28
+def 28
+admitida 28
+doble 28
+puerto 28
+parches 28
+submodule 28
+separada 27
+excede 27
+avisos 27
+coinciden 27
+aplicaciones 27
+seguro 27
+junto 27
+xml 27
+completo 27
+decir 27
+rastreo 27
+correspondiente 27
+compara 27
+long 27
+encabezados 27
+implementado 27
+l 27
+selinux 27
+status 27
+descartado 27
+indizado 27
+latino 27
+límites 26
+desactivado 26
+requerida 26
+tamaños 26
+cambiando 26
+obteniendo 26
+digital 26
+saliendo 26
+correctamente 26
+suficiente 26
+ignora 26
+empaquetado 26
+positivo 26
+dns 26
+corto 26
+faltan 26
+realiza 26
+expresiones 26
+separador 26
+impresión 26
+relajación 26
+insns 26
+precedente 26
+stubs 26
+regexp 26
+días 26
+ranura 26
+end 26
+posteriores 26
+conducto 26
+volumen 26
+ldap 26
+warc 26
+coincidencias 25
+xz 25
+descompresión 25
+posibles 25
+hardware 25
+cabeceras 25
+éxito 25
+update 25
+nuevas 25
+hecho 25
+posterior 25
+construir 25
+partes 25
+generado 25
+type 25
+probablemente 25
+tenga 25
+nuevos 25
+estaba 25
+manual 25
+bloquear 25
+path 25
+estadísticas 25
+causa 25
+máscara 25
+delimitador 25
+dígitos 25
+coincidente 25
+fatal 25
+intel 25
+gp 25
+intentando 25
+num 25
+name 25
+w 25
+openpgp 25
+creada 25
+cálculo 25
+log 25
+internacional 25
+cscope 25
+fast 24
+profundidad 24
+detallada 24
+activo 24
+manera 24
+recomienda 24
+gestor 24
+eliminando 24
+detenido 24
+comparar 24
+mínimo 24
+subproceso 24
+simbólica 24
+llama 24
+operadores 24
+izquierdo 24
+sale 24
+existentes 24
+psect 24
+small 24
+allá 24
+out 24
+truncado 24
+alineado 24
+faltante 24
+códigos 24
+ent 24
+desenredo 24
+index 24
+considera 24
+nul 24
+host 24
+paralelo 24
+tareas 24
+desubicación 24
+tienes 24
+revert 24
+mala 24
+rom 24
+adicionales 23
+considere 23
+suprime 23
+pruebe 23
+intento 23
+escritorio 23
+localmente 23
+bruto 23
+significa 23
+ir 23
+dominio 23
+vacíos 23
+terminó 23
+marcado 23
+cambió 23
+for 23
+esa 23
+reglas 23
+pre 23
+refiere 23
+especificados 23
+auxiliar 23
+fpu 23
+reubicable 23
+implementa 23
+recompile 23
+enlazar 23
+modificado 23
+enano 23
+dwarf 23
+reemplazar 23
+registrar 23
+fallida 23
+poner 23
+quieres 23
+rastreados 23
+empuje 23
+logitech 23
+restantes 22
+razón 22
+suprimir 22
+prueba 22
+compilación 22
+credenciales 22
+inesperadamente 22
+consulte 22
+text 22
+edición 22
+estrategia 22
+convierte 22
+proporcione 22
+python 22
+ignorado 22
+seguir 22
+inmediatamente 22
+internet 22
+fueron 22
+haciendo 22
+derecha 22
+octal 22
+opcional 22
+padre 22
+fp 22
+dinámicos 22
+esperada 22
+dso 22
+extensiones 22
+bfd 22
+columna 22
+juntas 22
+comillas 22
+capacidades 22
+gzip 22
+predicado 22
+regla 22
+crónica 22
+number 22
+árabe 22
+ee 22
+automático 21
+contraseñas 21
+bloqueado 21
+limpiar 21
+legible 21
+ejecute 21
+validación 21
+mediante 21
+manualmente 21
+urls 21
+evitar 21
+bundle 21
+marcar 21
+entonces 21
+usarse 21
+falla 21
+test 21
+pone 21
+globales 21
+dll 21
+reemplazo 21
+renombrado 21
+reservar 21
+compartidas 21
+nop 21
+excluye 21
+variante 21
+cursor 21
+bandera 21
+recursivamente 21
+parcial 21
+era 21
+orig 21
+caracter 21
+desbloquear 21
+rev 21
+dsp 21
+standard 21
+gstreamer 21
+uu 21
+delta 20
+inicia 20
+powerpc 20
+decodificar 20
+procesamiento 20
+web 20
+señales 20
+operaciones 20
+herramienta 20
+pasar 20
+indicar 20
+proporcionar 20
+abierto 20
+eliminación 20
+contrario 20
+pulse 20
+mostrada 20
+terminado 20
+definidos 20
+especificaciones 20
+formas 20
+unicode 20
+null 20
+emulación 20
+representar 20
+hex 20
+utilización 20
+mapa 20
+inválidas 20
+tendrá 20
+impar 20
+volver 20
+arreglar 20
+formada 20
+sacar 20
+mira 20
+pull 20
+concuerden 20
+incremental 20
+privada 20
+pkcs 20
+autentificación 20
+política 20
+dirmngr 20
+caduca 20
+microsoft 20
+húngaro 20
+nfa 20
+conserva 19
+largas 19
+totales 19
+recuperar 19
+omitido 19
+hacerlo 19
+descargando 19
+seleccionados 19
+actualizando 19
+root 19
+sobrescribir 19
+validez 19
+imprime 19
+comparación 19
+actualiza 19
+electrónico 19
+cuyo 19
+desactivada 19
+roto 19
+cdrom 19
+ese 19
+abortando 19
+dirs 19
+comienzo 19
+sub 19
+df 19
+secuencias 19
+previos 19
+compostura 19
+empleado 19
+corrupta 19
+equivocación 19
+indirecto 19
+plugin 19
+rc 19
+miembros 19
+data 19
+implica 19
+seguido 19
+reloj 19
+añada 19
+almacenar 19
+mandatos 19
+admitió 19
+reset 19
+empujar 19
+revisiones 19
+tus 19
+has 19
+caducidad 19
+gssapi 19
+qwerty 19
+válidas 18
+integridad 18
+abajo 18
+lzma 18
+mínima 18
+usan 18
+desea 18
+listas 18
+usará 18
+solicitado 18
+comprueba 18
+instalados 18
+manejador 18
+reciente 18
+algunas 18
+establecida 18
+emitir 18
+letras 18
+gui 18
+construcción 18
+ambigua 18
+ubicaciones 18
+evalúa 18
+revisar 18
+post 18
+ordena 18
+sinónimo 18
+in 18
+procesado 18
+esperar 18
+entidad 18
+pista 18
+detectada 18
+gcc 18
+mmo 18
+so 18
+desplaz 18
+sp 18
+unidades 18
+h 18
+escalar 18
+puedo 18
+quitar 18
+casillas 18
+zlib 18
+retroescritura 18
+save 18
+common 18
+cuarto 18
+portátil 18
+reflog 18
+rehusando 18
+siento 18
+cliente 18
+recibido 18
+colemak 18
+bcj 17
+ajustar 17
+none 17
+corta 17
+herramientas 17
+duro 17
+dada 17
+reporte 17
+fallido 17
+inmediata 17
+repositorios 17
+operativo 17
+listados 17
+similar 17
+comenzando 17
+haber 17
+garantía 17
+relación 17
+complementos 17
+finales 17
+así 17
+coincida 17
+seleccionada 17
+implementada 17
+permitida 17
+interoperabilidad 17
+opcionales 17
+complemento 17
+vea 17
+cifrar 17
+regulares 17
+show 17
+i 17
+excedido 17
+equivalente 17
+coloca 17
+vi 17
+idtrabajo 17
+if 17
+invertida 17
+finalizar 17
+acciones 17
+v 17
+cabe 17
+rsrc 17
+eabi 17
+alineada 17
+juntos 17
+omitir 17
+externos 17
+temporales 17`,
+	},
+	"pt": {
+		totals: [maxNgram + 1]int{0, 1196771, 1427806, 1196771},
+		counts: `
+o 132955
+e 131077
+a 129138
+r 87050
+i 84950
+s 79006
+d 66481
+n 62457
+t 57841
+m 48529
+c 48226
+l 38593
+p 38420
+u 36828
+v 21054
+f 20058
+ã 15925
+h 13631
+g 12746
+b 11810
+ç 10456
+q 6871
+á 6632
+x 5974
+í 5641
+z 4024
+é 3952
+ó 2738
+õ 2288
+k 2151
+y 1949
+ú 1923
+j 1748
+ê 1543
+w 1409
+â 303
+à 206
+ô 114
+º 55
+ü 4
+o_ 66896
+a_ 38114
+e_ 35937
+_d 31647
+de 26479
+s_ 26468
+_a 22192
+do 19165
+_e 19069
+ar 18888
+_p 18350
+es 18210
+r_ 17022
+_c 16831
+er 16708
+_s 16501
+ra 16392
+ão 15880
+_n 15079
+co 14991
+te 14933
+in 14377
+ad 13992
+os 13284
+re 13090
+_o 13022
+m_ 12897
+nt 12599
+en 12285
+or 11837
+ta 11464
+_f 11255
+da 10828
+al 10820
+_i 10270
+ma 9957
+pa 9886
+po 9871
+li 9838
+ro 9513
+me 9247
+ca 9215
+em 9073
+st 9046
+om 8799
+ri 8627
+se 8599
+ic 8594
+on 8365
+_u 8063
+fi 8026
+ve 7946
+as 7944
+to 7907
+_t 7874
+_m 7624
+ec 7399
+l_ 7102
+id 7092
+ti 6867
+an 6795
+um 6715
+çã 6666
+is 6630
+qu 6613
+no 6508
+_l 6384
+ir 6333
+tr 6220
+el 6048
+_r 6031
+nã 5930
+ss 5715
+nd 5418
+ci 5329
+na 5228
+lo 5226
+pr 5136
+im 5085
+di 5023
+pe 4975
+fo 4853
+ia 4808
+_v 4799
+io 4663
+aç 4650
+mp 4613
+it 4598
+ei 4530
+vo 4501
+ui 4482
+at 4419
+iv 4414
+mo 4384
+am 4344
+he 4255
+sa 4173
+ch 4103
+la 4080
+ha 4046
+so 3978
+nh 3971
+ex 3869
+_b 3781
+oc 3651
+si 3580
+ac 3434
+va 3420
+ne 3396
+sp 3375
+u_ 3320
+le 3253
+us 3246
+ce 3228
+rr 3188
+ou 3130
+et 3094
+nc 3015
+ni 2931
+rm 2893
+op 2883
+rq 2838
+é_ 2835
+mi 2793
+su 2792
+il 2787
+fa 2767
+ho 2757
+ut 2749
+sc 2729
+ue 2727
+ív 2712
+_é 2710
+od 2702
+rt 2689
+sí 2671
+gu 2652
+ig 2591
+ur 2590
+lh 2536
+t_ 2507
+ct 2442
+_g 2441
+if 2417
+i_ 2400
+ef 2357
+vi 2330
+tu 2327
+lt 2289
+õe 2286
+ol 2283
+ai 2274
+_q 2269
+ns 2255
+ua 2254
+cr 2243
+eg 2161
+nv 2101
+av 2080
+ab 2071
+ot 2065
+ap 2063
+iz 2052
+sã 2034
+cu 1913
+çõ 1893
+up 1873
+vá 1867
+ál 1866
+za 1851
+ao 1847
+ge 1827
+d_ 1744
+un 1742
+oi 1736
+n_ 1712
+pl 1705
+ip 1693
+pç 1642
+mb 1637
+gr 1627
+_h 1607
+ob 1589
+á_ 1562
+ul 1524
+ed 1506
+ga 1479
+ár 1475
+bi 1455
+rs 1448
+nf 1448
+b_ 1387
+ag 1365
+gi 1364
+ev 1335
+br 1322
+rg 1312
+ov 1293
+fe 1227
+ep 1215
+ru 1215
+ba 1203
+ib 1199
+be 1197
+ór 1190
+íd 1170
+rn 1161
+pi 1148
+eç 1141
+ça 1120
+p_ 1071
+nu 1070
+cl 1063
+c_ 1049
+xi 1025
+g_ 1024
+ea 1015
+nú 980
+dr 974
+lu 971
+iç 969
+tá 961
+bo 934
+tó 931
+bu 925
+ng 910
+az 908
+úm 904
+au 902
+aí 888
+xe 869
+bl 858
+xt 844
+rã 843
+z_ 837
+rá 826
+k_ 825
+go 814
+nç 810
+gn 806
+mu 806
+f_ 784
+ix 773
+rd 772
+ço 766
+y_ 753
+og 738
+ze 736
+xp 726
+x_ 711
+h_ 680
+ên 676
+cç 667
+hu 660
+rv 647
+du 636
+pt 631
+áv 605
+ll 602
+ja 590
+sh 584
+rc 579
+ub 577
+ím 574
+ud 566
+_j 563
+by 550
+yt 549
+eq 548
+ie 541
+má 538
+of 537
+ck 529
+xo 526
+_w 523
+ní 519
+fu 517
+ém 502
+bt 486
+fl 478
+lv 475
+ej 465
+pu 460
+bs 457
+xa 448
+ff 438
+ez 430
+úl 430
+eu 430
+zi 428
+sm 428
+th 427
+eb 421
+té 416
+_ú 414
+sá 411
+ke 411
+uf 408
+ps 401
+xc 400
+hi 400
+iá 397
+ês 395
+ju 377
+vr 375
+wa 365
+sq 362
+ê_ 358
+uç 352
+ós 349
+_k 346
+ty 346
+nk 343
+ts 338
+lm 336
+ó_ 336
+uá 329
+có 324
+je 323
+sv 323
+só 322
+mó 321
+ód 318
+ín 311
+pó 305
+cê 299
+_x 294
+rê 294
+ls 293
+_z 282
+eo 277
+ft 272
+mé 272
+tt 272
+uc 271
+_á 264
+ux 262
+rp 262
+bó 261
+ak 259
+ól 257
+gl 256
+lê 253
+há 249
+dê 246
+íc 246
+fr 244
+já 241
+ow 237
+bj 234
+âm 234
+ví 232
+rl 232
+aj 231
+ds 231
+sl 230
+_í 230
+oo 230
+ná 228
+pc 228
+áx 227
+ét 225
+ax 216
+gs 216
+ee 214
+wi 213
+sõ 211
+tã 207
+ay 207
+_à 206
+rf 203
+ér 201
+tí 199
+af 196
+sy 196
+tl 194
+tp 193
+mí 191
+uí 187
+oa 187
+pp 186
+à_ 183
+lg 183
+ug 182
+yp 182
+íg 177
+ún 176
+we 171
+tc 171
+sk 165
+mú 165
+ks 164
+lf 163
+úd 162
+w_ 160
+uê 160
+eú 160
+tê 156
+td 155
+ác 154
+rç 154
+iq 154
+sf 153
+áq 152
+ht 151
+nl 150
+lq 148
+tm 147
+bé 146
+ki 146
+cc 145
+oq 138
+cs 138
+dp 138
+tw 137
+cd 137
+fd 135
+lc 133
+ml 132
+ró 131
+ok 130
+ág 129
+v_ 129
+jo 127
+kt 127
+hh 127
+ír 126
+uz 126
+óp 126
+ym 125
+gm 122
+áu 120
+ús 120
+áf 120
+ld 120
+dv 120
+pk 119
+nj 118
+pá 115
+né 115
+nâ 115
+ew 113
+aq 111
+ry 108
+iu 107
+ré 107
+lp 106
+râ 104
+dd 104
+dl 104
+nq 104
+ka 102
+lí 101
+át 100
+kg 100
+q_ 99
+ás 98
+mm 98
+sd 96
+gp 95
+gg 95
+rõ 94
+cp 93
+tf 92
+wo 91
+ox 90
+êm 90
+íf 90
+rí 89
+_y 87
+pd 85
+dí 85
+hr 84
+ís 84
+rk 83
+cí 83
+dn 83
+yn 83
+fs 81
+rb 80
+ms 80
+qw 77
+dw 76
+xy 75
+ws 75
+iã 75
+mã 74
+és 74
+wl 73
+sb 72
+pô 72
+sg 71
+mt 70
+ân 69
+ku 69
+pg 67
+wn 67
+xã 66
+nó 65
+gh 63
+ôd 62
+vé 60
+iú 60
+ys 60
+dm 59
+xm 59
+sn 59
+nê 59
+py 58
+bm 57
+tâ 56
+ek 55
+nn 55
+np 55
+bc 54
+ii 54
+ly 54
+cm 53
+dy 53
+pú 52
+bí 52
+nº 52
+sr 52
+põ 51
+gí 51
+ey 51
+úb 51
+dá 50
+aw 50
+uv 50
+sw 50
+út 49
+lé 49
+íl 49
+df 49
+xz 47
+gt 47
+oç 46
+xu 46
+wh 46
+hú 46
+bp 44
+vm 44
+nr 44
+lz 43
+uo 43
+wd 43
+ló 43
+ót 43
+mn 43
+oj 42
+óg 42
+dx 42
+lb 42
+fp 42
+ny 42
+cá 42
+uj 41
+º_ 41
+gz 40
+fí 39
+ít 39
+dg 39
+nb 39
+tg 37
+ôn 37
+hd 37
+xx 37
+ã_ 36
+ph 36
+pm 36
+sé 36
+cb 35
+zá 34
+vp 34
+md 33
+ón 33
+ya 33
+lá 33
+dt 33
+wr 33
+kb 33
+aa 33
+ik 33
+éd 32
+tn 32
+oe 32
+ég 31
+qt 31
+fc 30
+fy 30
+bf 30
+db 30
+kp 30
+mr 30
+óx 30
+fm 29
+zo 29
+tx 29
+vs 29
+_de 21352
+de_ 17743
+ão_ 15873
+do_ 13848
+_co 10206
+os_ 9478
+_pa 7961
+da_ 7368
+ra_ 7248
+ado 7222
+_se 6911
+ent 6713
+ção 6666
+ar_ 6201
+_in 6196
+as_ 5959
+com 5944
+não 5929
+_nã 5891
+_a_ 5817
+par 5798
+es_ 5789
+_o_ 5769
+ara 5685
+ro_ 5463
+_es 5427
+em_ 5303
+_re 5174
+te_ 5067
+nte 4958
+to_ 4857
+con 4629
+fic 4626
+_no 4401
+er_ 4381
+or_ 4288
+_po 4285
+_um 4166
+_do 4154
+_ar 3993
+ada 3911
+_fo 3849
+men 3795
+ido 3675
+ica 3567
+_li 3530
+ter 3501
+açã 3467
+tra 3461
+_fi 3423
+ta_ 3390
+_pr 3365
+um_ 3361
+_ca 3320
+ivo 3264
+qui 3199
+est 3166
+pos 3160
+el_ 3142
+sta 3136
+ma_ 3080
+rad 3077
+vel 3063
+dos 3054
+eir 3053
+vo_ 2987
+_ex 2930
+ont 2877
+iro 2870
+ndo 2846
+_em 2813
+rqu 2802
+arq 2783
+for 2749
+res 2746
+des 2745
+_en 2719
+íve 2711
+che 2701
+_é_ 2698
+ist 2664
+uiv 2662
+and 2646
+por 2599
+_da 2585
+al_ 2580
+nto 2561
+que 2549
+_di 2513
+ich 2507
+ou_ 2487
+esp 2484
+ome 2423
+ver 2422
+io_ 2417
+hei 2413
+_te 2411
+eci 2400
+_fa 2372
+ess 2344
+_us 2324
+no_ 2313
+ntr 2293
+oss 2260
+ida 2242
+ões 2235
+ia_ 2220
+_ma 2188
+me_ 2181
+_op 2177
+mpo 2171
+om_ 2165
+lid 2135
+_qu 2126
+sív 2090
+ssí 2073
+rio 2069
+nom 2051
+_e_ 2047
+so_ 2044
+man 2034
+são 2033
+err 2017
+_im 2012
+esc 2011
+ha_ 2008
+_ou 2001
+_su 1972
+spe 1960
+_er 1931
+cad 1929
+era 1915
+alh 1905
+ifi 1898
+çõe 1893
+rro 1860
+ser 1856
+lin 1856
+ina 1856
+pro 1853
+_ta 1850
+pre 1832
+ir_ 1829
+_ao 1827
+áli 1821
+_si 1811
+vál 1792
+po_ 1784
+iza 1783
+ao_ 1777
+per 1774
+imp 1774
+uma 1752
+fin 1751
+_mo 1730
+mo_ 1729
+ini 1727
+liz 1722
+_ve 1720
+ura 1720
+tad 1716
+rma 1715
+car 1713
+is_ 1712
+_me 1706
+inv 1703
+se_ 1697
+omp 1695
+fal 1694
+orm 1676
+efi 1669
+dad 1669
+_al 1669
+ste 1668
+ali 1639
+tes 1621
+int 1616
+_va 1606
+opç 1605
+na_ 1601
+loc 1589
+ue_ 1589
+nha 1575
+nvá 1564
+def 1561
+str 1545
+inh 1537
+ria 1514
+tem 1506
+cia 1478
+rec 1460
+ho_ 1454
+lo_ 1442
+ere 1427
+ort 1419
+cri 1415
+_ne 1408
+_pe 1406
+ces 1375
+dor 1370
+_ap 1360
+oi_ 1345
+foi 1344
+tar 1339
+ári 1332
+_sa 1320
+usa 1312
+_as 1311
+ume 1285
+oca 1281
+ode 1278
+tiv 1273
+ten 1272
+tam 1249
+val 1247
+ve_ 1242
+alo 1233
+ame 1218
+alt 1217
+ade 1216
+ama 1212
+ion 1208
+end 1208
+pec 1197
+upo 1196
+lha 1192
+_lo 1189
+lho 1187
+ema 1181
+pri 1180
+nde 1171
+óri 1168
+ote 1168
+ros 1166
+dir 1162
+das 1155
+act 1147
+ora 1140
+re_ 1139
+ran 1129
+co_ 1125
+nta 1119
+pac 1119
+ca_ 1111
+ais 1101
+ita 1096
+oma 1095
+nal 1088
+sem 1085
+_os 1079
+aco 1077
+nci 1070
+ant 1070
+ual 1061
+arg 1061
+cid 1059
+ero 1055
+scr 1053
+ers 1052
+_na 1049
+tos 1041
+rgu 1036
+cio 1031
+ire 1028
+tro 1025
+lis 1022
+nho 1021
+ito 1020
+_at 1016
+lic 1015
+ili 1012
+_so 1006
+sso 1001
+til 1000
+ret 998
+sco 991
+rta 991
+mit 990
+rar 990
+tip 988
+pod 987
+pon 985
+erm 985
+cot 973
+mer 968
+cif 961
+rem 960
+pçã 959
+omo 959
+ati 957
+enc 957
+la_ 957
+caç 955
+ico 952
+ece 948
+_gr 947
+ici 945
+eve 931
+ecu 929
+_nú 926
+rmi 926
+ona 921
+_tr 919
+_ti 917
+cha 915
+tór 914
+roc 911
+nco 911
+núm 904
+ída 904
+_le 901
+cor 897
+min 892
+reg 892
+lor 887
+aíd 881
+ite 878
+tua 878
+pad 876
+nti 876
+_ch 876
+açõ 872
+hec 871
+_to 870
+tal 868
+sa_ 866
+adr 864
+ect 864
+cal 863
+saí 862
+mat 851
+rim 850
+_ac 848
+egu 847
+_cr 842
+age 840
+_b_ 833
+nen 831
+enh 830
+ext 829
+_an 828
+sin 826
+úme 825
+sup 819
+rão 818
+eri 816
+gum 813
+anh 809
+atu 807
+tri 807
+seg 805
+nfo 800
+inf 796
+nhe 794
+ime 790
+mpr 786
+eta 786
+tur 785
+onh 784
+fer 784
+ipo 783
+sec 783
+ins 780
+raç 779
+gra 778
+nec 774
+exi 771
+dis 769
+spo 765
+mes 764
+tic 763
+nor 762
+mas 761
+_ob 761
+olo 760
+ula 759
+sti 759
+tec 758
+içã 757
+mai 757
+ela 754
+drã 750
+abe 750
+stá 748
+emo 746
+_ba 746
+gem 743
+_st 739
+elo 738
+ost 737
+tá_ 735
+qua 734
+orr 731
+dic 730
+inc 726
+mod 725
+der 721
+rsã 717
+ala 717
+ine 715
+exp 713
+gur 712
+iva 711
+cam 706
+mbo 704
+exe 704
+odo 702
+cte 699
+am_ 698
+vis 697
+_ab 693
+zad 690
+vos 688
+_av 686
+ass 682
+rre 680
+pçõ 679
+ore 676
+rte 674
+dev 673
+ind 672
+rac 671
+rup 670
+zaç 669
+mov 668
+va_ 665
+ndi 664
+imi 660
+pen 659
+uti 659
+ato 657
+tor 653
+iar 648
+_au 646
+id_ 643
+tid 638
+ert 632
+ima 631
+ênc 630
+bol 630
+nst 629
+oce 628
+avi 627
+rel 622
+le_ 622
+lta 621
+nic 621
+_ig 618
+cçã 618
+ram 617
+_ut 615
+ecç 615
+den 613
+emp 612
+xec 612
+iso 611
+nid 609
+lar 607
+pas 606
+hum 606
+ce_ 605
+ref 605
+áve 602
+ign 600
+las 599
+lte 599
+ens 597
+red 594
+onf 594
+ave 593
+mos 591
+ern 590
+dif 588
+_mu 586
+uto 586
+sen 584
+ço_ 583
+sis 581
+_sí 580
+ele 579
+mpa 577
+ssã 576
+iti 575
+ena 574
+iga 573
+ove 573
+ede 571
+aut 571
+nhu 571
+eit 570
+erv 564
+gru 562
+rit 559
+rea 559
+one 558
+sím 558
+hou 557
+ata 557
+pli 555
+var 555
+lti 554
+ímb 553
+osi 547
+sar 544
+lem 544
+bre 543
+maç 541
+fil 538
+mpl 534
+_bi 533
+ne_ 532
+nar 530
+eto 529
+_nu 527
+yte 526
+isp 526
+byt 525
+cre 525
+etó 525
+tas 523
+equ 520
+sad 516
+_ge 516
+_by 513
+tex 513
+cla 513
+lig 512
+ape 512
+ast 508
+gno 506
+cur 506
+_id 503
+ém_ 502
+hav 502
+uan 501
+obr 501
+nov 500
+ari 500
+_sã 498
+_ad 494
+edi 494
+are 493
+ras 492
+rep 491
+orn 491
+eça 491
+dem 489
+nas 489
+ios 487
+vid 484
+uer 483
+_vi 482
+rev 481
+tod 480
+_or 480
+ori 479
+xis 476
+ing 474
+sob 473
+atr 472
+nça 469
+cut 469
+has 467
+_fu 467
+ocu 464
+eno 464
+zer 463
+lim 462
+ça_ 461
+go_ 460
+ons 459
+let 459
+ond 457
+spa 457
+dei 456
+egi 452
+xo_ 451
+ssi 451
+ace 450
+uso 449
+art 449
+rti 449
+uta 448
+tab 447
+nad 446
+ope 445
+xto 444
+ssa 444
+ota 443
+igu 441
+lad 439
+itu 438
+sim 436
+tan 435
+_má 434
+ias 433
+sol 432
+fun 431
+obt 431
+mem 430
+nív 424
+ll_ 423
+últ 422
+ple 422
+ger 421
+tin 420
+arr 419
+ile 418
+uit 418
+amp 418
+ecl 418
+im_ 417
+ese 417
+nfi 416
+iad 415
+fon 413
+imo 412
+nir 411
+sto 411
+col 410
+reç 409
+rir 406
+sub 405
+tat 405
+ilh 404
+ler 403
+cti 403
+lit 401
+ns_ 401
+tim 398
+ren 398
+los 397
+pel 397
+ês_ 395
+isa 394
+out 393
+ogr 390
+dia 390
+cas 389
+ssá 389
+exc 388
+num 388
+in_ 388
+nes 387
+_un 387
+clu 385
+ega 385
+sár 384
+_pi 384
+ino 384
+gis 383
+abr 382
+efe 382
+faz 380
+eço 380
+ctu 379
+_el 379
+_ho 378
+on_ 378
+bri 376
+rib 376
+amb 374
+ixo 373
+ibu 373
+rra 373
+rig 372
+eme 371
+oco 369
+eja 369
+_n_ 369
+cab 368
+dep 368
+vez 365
+_sh 362
+rna 361
+aze 361
+ult 361
+esm 361
+igo 360
+met 360
+squ 358
+anç 358
+pal 358
+rne 357
+ira 355
+cap 355
+ute 355
+mal 353
+ez_ 352
+sit 352
+vei 350
+iáv 350
+xpr 349
+rno 348
+nos 347
+mad 347
+smo 347
+nat 346
+us_ 346
+ial 345
+eis 344
+fix 343
+beç 342
+apl 342
+pil 341
+laç 341
+tre 338
+çal 338
+dig 338
+pe_ 337
+gar 337
+eco 336
+taç 336
+ova 336
+adi 336
+usu 335
+ava 334
+mpi 334
+riá 334
+odi 333
+blo 333
+tru 333
+mag 332
+_la 332
+ior 329
+_vo 329
+ord 329
+suá 329
+uár 329
+ice 327
+arc 327
+bte 326
+ink 326
+cum 325
+_có 324
+sel 324
+tém 321
+epo 320
+_só 319
+ide 319
+niç 318
+_bl 318
+só_ 318
+uni 316
+vio 316
+fig 315
+cta 313
+esq 312
+_fl 312
+abi 312
+uda 311
+cul 311
+apa 311
+lme 308
+gin 308
+zar 308
+bil 308
+rif 306
+ian 306
+ós_ 305
+use 305
+pós 304
+ja_ 304
+pid 303
+pla 303
+uin 302
+sca 302
+lav 302
+gaç 300
+lat 300
+doc 300
+aço 298
+mar 298
+avr 297
+epa 296
+cos 296
+ham 295
+rid 294
+_du 293
+rog 293
+vra 292
+ng_ 292
+rva 291
+paç 291
+esa 290
+vor 290
+uçã 289
+_am 289
+et_ 289
+_bu 288
+cen 288
+apó 287
+_mi 287
+can 287
+esv 287
+del 286
+did 286
+sse 286
+nté 286
+azi 286
+vaz 285
+nçã 285
+he_ 285
+ami 285
+_vá 284
+had 284
+_s_ 284
+imb 283
+cer 283
+unç 283
+rên 282
+rin 280
+eia 279
+gui 279
+cat 279
+ase 279
+rip 278
+_fe 278
+mui 278
+anc 278
+ch_ 276
+bin 276
+svi 276
+eli 275
+pt_ 275
+pat 274
+bel 273
+war 273
+rvi 272
+rol 272
+nsa 271
+ric 271
+nve 270
+tir 270
+rso 269
+_is 269
+cod 269
+ui_ 269
+tif 269
+ila 268
+voc 268
+rav 266
+sep 265
+urs 265
+ano 265
+ble 264
+_úl 263
+mon 263
+unt 263
+sag 262
+gul 261
+hor 261
+_ha 261
+ain 260
+but 260
+uir 259
+nam 259
+ech 258
+via 258
+ana 257
+rá_ 257
+hel 256
+erd 255
+ead 254
+det 254
+ts_ 253
+mei 253
+nda 253
+emó 252
+mór 252
+alv 252
+emi 252
+ipt 251
+óli 251
+ole 251
+siç 250
+utr 250
+tio 250
+bas 250
+log 250
+olu 249
+ola 249
+aba 248
+be_ 248
+iss 247
+cód 247
+rei 247
+ell 247
+_há 246
+há_ 246
+not 246
+mbó 245
+ból 245
+ate 245
+ódi 245
+alm 244
+rom 244
+erê 243
+etr 242
+gen 241
+ulo 241
+hos 241
+bal 241
+und 239
+unc 239
+mbi 239
+eu_ 238
+_ní 238
+_th 237
+_já 236
+já_ 236
+dat 235
+bli 235
+ará 234
+lt_ 234
+xim 233
+ong 233
+cê_ 233
+sos 232
+lon 232
+lec 232
+ocê 232
+ale 232
+tag 232
+ock 232
+rár 231
+suf 230
+rda 230
+_ro 230
+req 229
+seu 229
+gad 229
+_he 229
+_ví 228
+sai 228
+ois 228
+máx 227
+gua 227
+obj 227
+ans 226
+vol 225
+flu 225
+din 225
+ubs 225
+ive 224
+ien 224
+xib 224
+ani 222
+ufi 220
+az_ 220
+git 220
+bje 220
+eal 220
+rab 220
+st_ 219
+ovo 219
+olv 219
+fo_ 219
+lei 218
+ced 217
+ete 217
+ipl 216
+rca 216
+ck_ 216
+sej 215
+rat 215
+eçã 215
+bit 214
+mor 214
+she 214
+mul 213
+plo 213
+ncl 213
+nca 213
+lve 212
+vad 212
+sõe 211
+har 211
+ua_ 211
+xce 210
+il_ 210
+lan 210
+epe 210
+top 210
+ndê 209
+mic 208
+tão 207
+rde 207
+_aj 206
+aju 206
+ls_ 206
+at_ 206
+ga_ 205
+ixa 205
+aix 204
+fec 204
+onv 204
+_fr 204
+tit 204
+dec 203
+inu 203
+_oc 203
+ps_ 203
+_ze 202
+ibe 202
+íci 202
+it_ 200
+ibi 200
+emb 200
+ber 199
+áxi 198
+gun 198
+pia 198
+ack 198
+upl 198
+orá 198
+dên 197
+_cu 197
+igi 197
+nk_ 196
+bst 196
+_pl 196
+oda 195
+fim 194
+ban 193
+exa 192
+_sc 191
+_ur 191
+env 190
+ife 190
+_ce 190
+eti 190
+siv 190
+ed_ 190
+ai_ 189
+cop 189
+nu_ 189
+rop 188
+lvo 188
+nár 187
+_ra 186
+erá 186
+_br 186
+rl_ 186
+eam 186
+oní 184
+sum 184
+_wi 184
+gid 183
+_à_ 183
+lia 183
+eca 182
+bra 182
+les 182
+tus 181
+ctó 181
+avo 181
+índ 180
+soc 179
+xcl 178
+_ín 178
+ços 177
+ty_ 177
+éri 176
+sof 175
+nso 174
+nt_ 174
+set 173
+uid 173
+xtr 173
+sh_ 173
+cis 172
+ssu 172
+op_ 172
+leg 171
+rie 170
+oft 170
+nsi 170
+_hi 170
+nai 169
+all 169
+bai 168
+opr 168
+mis 168
+ff_ 168
+tot 167
+dup 167
+ot_ 167
+rob 167
+ak_ 167
+seç 167
+ses 166
+sal 166
+utu 166
+mac 166
+tui 165
+buf 165
+enç 165
+_mú 165
+ype 165
+jud 164
+zio 164
+fav 164
+evi 163
+oni 163
+xte 163
+ach 163
+sig 163
+typ 163
+cto 162
+ige 162
+dio 162
+the 162
+san 161
+arm 161
+uff 161
+_gn 161
+an_ 160
+ds_ 160
+teú 160
+eúd 160
+údo 160
+mir 159
+tax 159
+ane 159
+ffe 159
+esu 159
+_ja 159
+_sy 158
+tai 157
+lui 157
+lus 157
+rai 157
+nvi 157
+nul 156
+efa 156
+uem 156
+_ag 155
+mud 155
+ibl 155
+gnu 155
+nd_ 155
+múl 155
+fra 155
+dar 154
+scu 154
+ss_ 154
+nce 153
+xa_ 153
+máq 152
+áqu 152
+rác 152
+uce 152
+cei 152
+nel 151
+suc 151
+bib 151
+ogi 151
+nex 151
+ay_ 151
+alg 150
+eo_ 150
+jun 150
+win 150
+ex_ 150
+len 149
+sam 149
+tei 149
+jet 149
+ec_ 149
+gat 148
+vim 148
+olh 148
+áct 148
+som 147
+obl 147
+map 147
+alq 146
+lqu 146
+nse 146
+_of 146
+lio 146
+cit 146
+dow 146
+_it 145
+iqu 145
+rs_ 145
+ix_ 145
+_ct 145
+xe_ 144
+isc 144
+_gi 144
+tig 144
+iot 144
+bro 144
+evo 143
+sua 143
+ol_ 143
+egr 142
+uxo 142
+bie 142
+rc_ 142
+don 142
+oto 142
+oci 142
+lux 141
+nga 141
+ngl 141
+aci 141
+mbr 141
+off 140
+med 139
+eda 139
+uai 139
+_cl 139
+éti 139
+ral 138
+oqu 138
+ven 138
+riz 138
+cim 137
+axe 137
+ut_ 137
+fli 137
+ltr 136
+bar 136
+rot 136
+ruç 136
+atí 135
+ivi 135
+udi 135
+vas 134
+uíd 134
+pur 134
+sid 133
+lhe 133
+_ga 133
+iná 133
+aio 133
+oc_ 133
+ovi 132
+ip_ 132
+rto 132
+oin 132
+ebi 132
+his 132
+xad 132
+içõ 131
+mbé 131
+bém 131
+mid 131
+app 131
+gs_ 131
+erp 130
+apt 130
+rdo 130
+cuç 130
+asi 129
+ic_ 129
+poi 129
+cro 129
+meç 129
+iaç 129
+_ci 129
+ilo 129
+ilt 128
+uis 128
+orç 128
+ag_ 128
+_ár 128
+mét 128
+ear 127
+stã 127
+en_ 127
+ied 127
+sul 127
+seq 127
+cke 127
+pes 126
+sic 126
+rod 126
+erf 126
+ard 125
+std 125
+eso 125
+_ty 125
+deb 125
+omi 124
+sab 124
+vam 124
+ge_ 124
+twa 124
+bui 124
+nfl 124
+hab 124
+of_ 124
+lês 123
+ad_ 123
+ize 123
+net 123
+âmi 123
+lay 123
+glê 122
+_ed 122
+xpo 122
+nit 121
+pto 121
+ib_ 120
+esl 120
+tom 120
+ftw 120
+uiç 120
+ml_ 120
+nsã 120
+rsi 120
+jec 120
+ft_ 120
+za_ 119
+epr 119
+_áu 119
+zia 119
+ncr 119
+cac 118
+_c_ 118
+rça 117
+sia 117
+opi 117
+neg 116
+air 116
+ub_ 116
+_cd 116
+epu 115
+uro 115
+ígi 115
+quê 115
+inâ 115
+nâm 115
+th_ 115
+_wa 115
+vír 114
+írg 114
+nua 114
+ri_ 114
+esk 114
+_pá 113
+wer 113
+uar 113
+coi 113
+áud 113
+_on 113
+_ru 113
+sym 113
+lut 112
+rou 112
+fac 112
+lun 112
+ído 111
+ssõ 111
+sui 111
+ree 111
+_il 111
+skt 111
+kto 111
+rt_ 111
+una 111
+xpi 110
+pir 110
+pág 110
+ági 110
+her 110
+mot 110
+pan 110
+rpr 110
+doi 110
+çar 110
+fei 110
+_ht 110
+rru 110
+sun 110
+ceb 109
+grá 109
+ngo 109
+_lê 108
+lê_ 108
+lib 108
+tív 108
+mér 108
+spl 108
+_dv 108
+ono 107
+ves 107
+íde 107
+deo 107
+úsc 107
+its 106
+duz 106
+_ai 106
+tou 106
+bac 106
+mea 106
+loq 105
+pul 105
+up_ 105
+rtu 105
+son 104
+_om 104
+ein 104
+naç 104
+pst 104
+enu 104
+ang 104
+onj 104
+nju 104
+_ún 103
+úni 103
+odu 103
+vin 103
+ráf 103
+áfi 103
+un_ 103
+iai 102
+gor 102
+ze_ 102
+obs 102
+umé 102
+dit 102
+ail 102
+_ps 102
+bso 101
+_be 101
+pag 101
+víd 101
+uên 101
+sio 101
+lob 101
+mak 101
+teg 100
+isi 100
+arâ 100
+râm 100
+âme 100
+ped 100
+ef_ 100
+pkg 100
+rus 100
+_ir 100
+sac 99
+rut 99
+riç 99
+url 99
+_gl 99
+ct_ 99
+íni 98
+adu 98
+áti 98
+iat 98
+aga 98
+glo 98
+_mé 98
+fd_ 98
+ker 98
+ux_ 98
+nqu 98
+uen 97
+ks_ 97
+omu 97
+iní 97
+mín 96
+nch 96
+nib 96
+nif 96
+_ef 96
+_dp 96
+opc 95
+ner 95
+vir 95
+ncu 95
+aps 95
+rõe 94
+ctr 94
+paz 94
+aqu 94
+_fd 94
+tp_ 94
+opt 94
+enq 94
+_mí 93
+nim 93
+níc 93
+riv 93
+toc 93
+_ke 93
+gme 93
+ash 93
+nip 92
+ry_ 92
+eio 92
+lgu 92
+_ec 92
+gal 92
+omb 92
+_pu 92
+dam 92
+kg_ 92
+táv 92
+rak 92
+_sp 91
+mp_ 91
+zen 91
+got 91
+cai 91
+ust 90
+ipu 90
+upe 90
+nsf 90
+bid 90
+abu 90
+bul 90
+êm_ 90
+dpk 90
+ópi 90
+ges 89
+xem 89
+uns 89
+ntu 89
+iz_ 89
+ket 89
+hex 89
+dvo 89
+ató 88
+vem 88
+onc 88
+têm 88
+ath 88
+pa_ 88
+jan 88
+rd_ 87
+fa_ 87
+vej 87
+rg_ 87
+_eq 87
+iff 87
+nux 87
+rty 87
+ill 86
+_et 86
+_ui 86
+div 86
+nio 86
+itm 86
+tch 86
+drõ 85
+nks 85
+maz 85
+_aç 85
+rce 85
+han 85
+tog 85
+loa 85
+pré 85
+oné 85
+uet 84
+díg 84
+run 84
+bi_ 84
+trl 84
+dou 83
+dul 83
+rme 83
+cci 83
+oba 83
+get 83
+_x_ 83
+oso 82
+ig_ 82
+cie 82
+ron 82
+ecc 82
+_dí 82
+rfa 82
+nét 82
+tár 81
+pc_ 81
+bus 81
+aso 81
+ap_ 81
+uri 81
+tu_ 81
+slo 80
+opo 80
+ug_ 80
+liv 80
+tel 80
+bti 80
+alf 80
+stu 80
+tty 80
+hhh 80
+tls 80
+úm_ 79
+cho 79
+_t_ 79
+upt 79
+cin 79
+peq 78
+alc 78
+pci 78
+ipe 78
+iço 78
+htt 78
+ttp 78
+egm 78
+gio 78
+ego 77
+idi 77
+ças 77
+og_ 77
+cóp 77
+ook 77
+uss 77
+_qw 77
+qwe 77
+_ev 76
+nsu 76
+lto 76
+gos 76
+_tê 76
+asp 76
+pu_ 75
+pol 75
+non 75
+aus 75
+rox 75
+ivr 75
+oad 75
+ífi 75
+gge 75
+bos 74
+gue 74
+add 74
+raf 74
+urc 74
+pps 74
+_d_ 74
+gni 74
+anu 74
+ecí 74
+dns 74
+nou 74
+pta 74
+abs 73
+viç 73
+sfe 73
+ene 73
+our 73
+ws_ 73
+fla 73
+cíf 73
+ebr 73
+ré_ 73
+rri 73
+shi 73
+ow_ 73
+osh 73
+_af 72
+nis 72
+_eu 72
+xy_ 72
+luí 72
+hom 72
+líc 72
+stó 72
+_dn 72
+sli 72
+_pc 72
+ake 72
+rof 71
+mil 71
+sor 71
+_cp 71
+wor 71
+oxy 71
+cs_ 71
+asc 71
+cd_ 71
+aro 71
+ewl 71
+pej 70
+cel 70
+usi 70
+pró 70
+éto 70
+bun 70
+ok_ 70
+oti 70
+tf_ 70
+ffi 70
+upr 69
+hes 69
+nan 69
+_mó 69
+mód 69
+ódu 69
+ngu 69
+ião 69
+_pô 69
+dur 68
+pea 68
+eus 68
+lum 68
+_ub 68
+ubu 68
+igg 68
+emã 68
+mão 68
+rsõ 67
+_ni 67
+sat 67
+put 67
+erg 67
+ipa 67
+dan 67
+oct 67
+fai 67
+rn_ 67
+_tt 67
+usc 66
+_ki 66
+vre 66
+plí 66
+exã 66
+xão 66
+_ju 66
+lix 66
+ncê 66
+cês 66
+zip 65
+bor 65
+apr 65
+_ds 65
+sus 65
+hif 65
+agr 64
+rm_ 64
+ids 64
+new 64
+_bo 64
+abl 64
+usã 64
+eof 64
+lf_ 64
+elf 64
+_p_ 64
+_tu 64
+ymb 64
+urt 63
+uas 63
+até 63
+pip 63
+erc 63
+eva 63
+tiq 63
+ie_ 63
+oot 63
+dom 63
+_tl 63
+ege 63
+_g_ 63
+ift 63
+_sl 63
+etu 62
+tau 62
+aur 62
+és_ 62
+roo 62
+bs_ 62
+ude 62
+pôd 62
+ôde 62
+ueb 62
+xpa 62
+ows 62
+au_ 62
+dwa 61
+abo 61
+uad 61
+eb_ 61
+chi 61
+own 61
+nge 61
+irt 61
+eck 61
+usp 61
+atc 61
+uzi 60
+cpu 60
+hre 60
+fia 60
+vés 60
+iu_ 60
+_jo 60
+aiú 60
+iús 60
+cks 60
+pet 60
+_gs 60
+lag 60
+sil 59
+fiq 59
+bug 59
+rsa 59
+aos 59
+oli 59
+apo 59
+isã 59
+sci 59
+acr 59
+ac_ 59
+tul 59
+uçõ 59
+ul_ 59
+ára 59
+arp 59
+nês 59
+uês 59
+fas 58
+uea 58
+elh 58
+lp_ 58
+té_ 58
+cip 58
+gre 58
+_ic 58
+_if 58
+rp_ 58
+gri 57
+dua 57
+een 57
+ea_ 57
+si_ 57
+xar 57
+tib 57
+sce 57
+bia 57
+nin 57
+utá 57
+nsp 57
+_f_ 57
+fol 57
+erã 56
+lca 56
+xos 56
+umi 56
+six 56
+lev 55
+ptu 55
+ken 55
+uei 55
+_xm 55
+rpo 55
+rov 55
+lea 55
+eje 55
+siz 55
+nc_ 55
+_m_ 55
+if_ 54
+sas 54
+mum 54
+vár 54
+esg 54
+sgo 54
+agu 54
+ubl 54
+çam 54
+eçõ 54
+ld_ 54
+llo 54
+esi 54
+_eo 54
+pr_ 54
+_gp 54
+sha 54
+tl_ 54
+afe 53
+trá 53
+pam 53
+afo 53
+sou 53
+ags 53
+_ip 53
+uil 53
+_go 53
+erb 52
+nvo 52
+fet 52
+tac 52
+_up 52
+acç 52
+elm 52
+vai 52
+põe 51
+jo_ 51
+zes 51
+jus 51
+bru 51
+sc_ 51
+itó 51
+als 51
+kup 51
+ab_ 51
+rui 51
+iag 51
+ii_ 51
+xt_ 51
+púb 51
+úbl 51
+pse 51
+_nº 51
+_u_ 51
+_tc 51
+oná 50
+sde 50
+mát 50
+rci 50
+ntá 50
+_hu 50
+nem 50
+rag 50
+ígu 50
+boo 50
+plt 50
+icr 50
+lug 50
+esd 49
+dês 49
+xig 49
+tdo 49
+gív 49
+fre 49
+niz 49
+_pú 49
+udo 49
+pl_ 49
+elp 49
+cçõ 49
+mau 49
+gan 49
+tân 48
+õe_ 48
+jad 48
+_út 48
+ecr 48
+xml 48
+tho 48
+míd 48
+ídi 48
+omí 48
+inú 48
+íli 48
+sma 48
+kil 48
+tub 48
+_lt 48
+_wo 48
+_xz 47
+buí 47
+iam 47
+cku 47
+_we 47
+íst 47
+nús 47
+lgo 47
+nçõ 47
+gp_ 47
+_cs 47
+eg_ 47
+_dw 47
+wli 47
+xz_ 46
+úti 46
+mel 46
+eze 46
+mib 46
+ogo 46
+jei 46
+nac 46
+_mm 46
+_hú 46
+hún 46
+úng 46
+duç 45
+ago 45
+oçã 45
+nag 45
+gic 45
+mun 45
+cra 45
+rer 45
+orq 45`,
+		wordTotal: 231035,
+		words: `
+de 15558
+não 5890
+a 5817
+o 5769
+para 4436
+do 3236
+um 2738
+é 2698
+em 2450
+arquivo 2093
+com 2069
+e 2047
+ficheiro 1881
+ao 1765
+ou 1688
+da 1550
+erro 1550
+nome 1515
+uma 1417
+no 1413
+foi 1344
+que 1316
+por 1186
+ser 1132
+possível 1072
+os 1064
+inválido 1024
+se 982
+impossível 965
+como 946
+opção 922
+sem 893
+entrada 870
+saída 859
+b 833
+na 805
+linha 800
+pode 791
+as 739
+padrão 736
+tipo 731
+está 724
+número 723
+falha 714
+opções 674
+lista 666
+tamanho 665
+valor 665
+comando 659
+versão 638
+dados 568
+pacote 567
+secção 550
+aviso 532
+falhou 508
+ficheiros 504
+formato 498
+são 498
+inválida 488
+arquivos 487
+sistema 476
+tem 466
+argumento 466
+grupo 445
+for 444
+uso 421
+usa 417
+diretório 407
+cada 401
+desconhecido 401
+mais 393
+mas 382
+chave 382
+definir 374
+criar 371
+n 369
+linhas 360
+modo 359
+ler 358
+nenhum 358
+deve 358
+nomes 356
+bytes 346
+símbolo 341
+informação 340
+pacotes 323
+usar 322
+mostra 321
+só 318
+caracteres 311
+apenas 309
+campo 304
+endereço 301
+vez 300
+abrir 300
+autenticação 297
+estado 296
+utilizador 296
+suporte 294
+este 293
+argumentos 292
+pasta 287
+obter 287
+imagem 285
+s 284
+após 281
+sinal 278
+mesmo 276
+existe 275
+id 274
+documento 274
+necessária 271
+usuário 271
+contém 269
+cabeçalho 269
+especificado 269
+remover 265
+fonte 263
+dos 262
+define 259
+expressão 259
+comandos 258
+memória 252
+encontrado 252
+há 246
+pelo 245
+processo 244
+interno 239
+já 236
+teclas 234
+esta 233
+variável 232
+você 232
+código 232
+função 231
+desvio 227
+texto 226
+tabela 224
+nível 220
+quando 219
+todos 218
+palavra 215
+muito 213
+falta 213
+símbolos 211
+caractere 210
+tempo 205
+use 204
+antes 204
+nenhuma 201
+contexto 200
+espaço 200
+cadeia 199
+segurança 197
+ligação 195
+shell 195
+fora 195
+fazer 193
+fim 193
+programa 190
+imprime 190
+sobre 189
+desconhecida 189
+componente 189
+relocalização 189
+local 188
+novo 185
+nova 184
+à 183
+intervalo 183
+valores 181
+caminho 180
+directório 179
+definido 178
+leitura 175
+usado 174
+predefinição 173
+status 173
+mensagem 172
+executar 172
+máximo 169
+menos 167
+base 166
+informações 165
+destino 164
+emite 162
+assinatura 161
+esperado 161
+zero 160
+senha 158
+favor 157
+byte 157
+podem 156
+inesperado 154
+exibe 154
+remove 154
+ajuda 153
+alvo 152
+suportado 150
+entre 149
+limite 149
+servidor 149
+todas 148
+carácter 148
+índice 148
+grande 147
+qualquer 146
+pid 144
+erros 143
+referência 143
+vazio 142
+mostrar 142
+seu 141
+conteúdo 141
+seja 140
+das 139
+primeiro 139
+sufixo 139
+último 139
+link 139
+sucesso 139
+máquina 138
+atual 138
+total 137
+especificar 137
+escrever 137
+dispositivo 135
+configuração 133
+mortas 132
+também 131
+ambiente 131
+sintaxe 131
+consola 130
+atributo 130
+escrita 129
+escrito 129
+faltando 129
+pilha 129
+bloco 128
+será 128
+final 128
+seção 128
+gnu 127
+largura 127
+alterar 126
+buffer 126
+entradas 126
+alt 126
+fluxo 124
+execução 124
+script 124
+faz 123
+cria 123
+inglês 122
+data 121
+verificação 120
+ponto 120
+the 118
+c 118
+compressão 117
+especificação 117
+válido 116
+encontrada 116
+ignorar 115
+trabalho 115
+registo 115
+pastas 115
+especificada 114
+instalar 114
+origem 114
+actual 114
+lock 114
+áudio 113
+localização 113
+tam 112
+usando 112
+usada 112
+alterações 112
+hora 112
+encontrar 112
+foram 111
+esquema 111
+estilo 111
+modelo 110
+type 110
+permitido 109
+regular 109
+biblioteca 109
+diretórios 109
+lê 108
+forma 108
+elemento 107
+software 107
+depuração 107
+dois 107
+imprimir 107
+atributos 107
+string 107
+sai 105
+controle 105
+suportada 104
+possui 104
+somente 104
+processos 104
+maior 104
+definida 103
+números 101
+display 101
+conjunto 100
+verdadeiro 99
+ainda 98
+desktop 98
+propriedade 98
+variáveis 98
+sessão 97
+sua 97
+última 97
+tag 97
+certificado 97
+adicionar 96
+membro 96
+file 96
+ignora 95
+compilação 95
+bits 94
+página 94
+ter 94
+ignorando 94
+acesso 94
+disponível 94
+igual 94
+tar 94
+terminal 93
+num 93
+cache 93
+login 93
+objeto 93
+sun 93
+precisa 92
+sub 91
+metadados 91
+vídeo 91
+vazia 91
+arquitectura 91
+instalado 90
+outro 90
+dpkg 90
+vim 90
+demais 89
+caps 89
+ligações 88
+temporário 88
+executa 88
+enquanto 88
+inicial 87
+recurso 87
+inteiro 86
+definição 86
+veja 86
+conter 86
+disco 86
+dvorak 86
+fechar 85
+processamento 85
+retorna 85
+normal 84
+devolve 84
+ignorado 84
+partir 84
+início 84
+menor 84
+fornecido 84
+chamada 84
+in 84
+ctrl 84
+conta 83
+estar 83
+chaves 83
+antigo 83
+x 83
+isso 82
+necessário 82
+simbólico 82
+verificar 82
+pela 82
+aplicativo 82
+operação 81
+links 81
+espaços 81
+requer 81
+campos 81
+tecla 81
+seguintes 80
+outros 80
+parte 80
+contra 80
+localizar 80
+selinux 80
+núm 79
+padrões 79
+aplicação 79
+neste 79
+objecto 79
+t 79
+dono 78
+dependências 78
+grupos 78
+posição 77
+simbólica 77
+par 77
+barra 77
+binário 77
+alocar 77
+blocos 76
+incapaz 76
+permite 76
+correspondente 76
+têm 76
+especifica 75
+section 75
+estão 74
+segundos 74
+analisar 74
+uid 74
+dentro 74
+interface 74
+problema 74
+sequência 74
+d 74
+devem 73
+palavras 73
+url 73
+existente 73
+extensão 73
+dado 73
+seguir 73
+ordem 73
+pré 73
+instrução 73
+vírgula 72
+exemplo 72
+ilegal 72
+appstream 72
+troca 72
+colunas 72
+fonético 72
+endereços 71
+info 71
+mudar 70
+sair 70
+especial 70
+uri 70
+cabeçalhos 69
+tente 69
+licença 69
+proxy 69
+problemas 69
+método 69
+nota 69
+macintosh 69
+devido 68
+diferente 68
+permitir 68
+ação 68
+disponíveis 68
+onde 68
+locais 68
+ubuntu 68
+alemão 68
+stat 67
+controlo 67
+classe 67
+quantidade 66
+mensagens 66
+sobreposição 66
+permissões 66
+reconhecido 66
+processar 66
+nos 66
+executado 66
+metainfo 66
+cd 66
+descritor 66
+francês 66
+terminar 65
+iniciar 65
+remoto 65
+fd 65
+numérico 65
+histórico 65
+filho 65
+predefinido 65
+flutuante 65
+win 65
+escreve 64
+bit 64
+versões 64
+nulo 64
+atualizações 64
+depois 64
+prefixo 64
+errado 64
+ligar 64
+conexão 64
+marca 64
+cópia 64
+mal 64
+p 64
+diff 64
+russo 64
+registrador 64
+isto 63
+era 63
+converter 63
+especificados 63
+g 63
+único 63
+trata 63
+múltiplas 63
+janela 63
+shift 63
+tentar 62
+tarefa 62
+descrição 62
+chamado 62
+pôde 62
+longo 62
+escape 62
+nada 61
+listar 61
+seus 61
+corrompido 61
+operando 61
+sinais 60
+serviço 60
+dias 60
+gid 60
+adiciona 60
+conversão 60
+dns 60
+http 60
+condicional 60
+múltiplos 60
+tty 60
+tls 60
+esquerdo 60
+procurar 59
+aos 59
+sempre 59
+ele 59
+to 59
+codificação 59
+direito 59
+registro 59
+anterior 58
+até 58
+detalhes 58
+item 58
+rede 58
+home 58
+saltar 58
+menu 58
+recursos 58
+determinar 58
+unidade 58
+horário 58
+filtro 57
+apt 57
+aspas 57
+socket 57
+altera 57
+renomear 57
+f 57
+copiar 56
+espera 56
+gera 56
+substituição 56
+posix 56
+windows 56
+elf 56
+longa 55
+auto 55
+removido 55
+estiver 55
+componentes 55
+conflito 55
+operador 55
+arg 55
+demasiado 55
+m 55
+qwerty 55
+aplicativos 55
+essa 54
+comum 54
+fornecida 54
+debian 54
+global 54
+válidos 53
+fich 53
+configurações 53
+utilizar 53
+carregar 53
+corresponde 53
+qual 53
+encontrados 53
+original 53
+invertida 53
+parâmetros 52
+real 52
+numa 52
+segmento 52
+cpu 51
+bandeiras 51
+utilização 51
+duplicado 51
+referências 51
+estendido 51
+banco 51
+u 51
+warc 51
+fontes 50
+root 50
+livre 50
+dígitos 50
+primeira 50
+topo 50
+modificação 50
+terminou 50
+usados 50
+executável 50
+opcional 50
+diferentes 50
+of 50
+ex 50
+abaixo 49
+desde 49
+serão 49
+criação 49
+caso 49
+simples 49
+equivalente 49
+mau 49
+kernel 49
+usuários 49
+força 48
+procura 48
+exclui 48
+necessita 48
+parâmetro 48
+letras 48
+ids 48
+xml 48
+esquerda 48
+forem 48
+octal 48
+transporte 48
+tabulação 48
+reloc 48
+abi 48
+sendo 47
+alteração 47
+identificador 47
+aceita 47
+instalação 47
+forçar 47
+permitida 47
+registos 47
+enviar 47
+simbólicas 47
+help 47
+ligado 47
+instruções 47
+xz 46
+suprime 46
+mib 46
+stdout 46
+digite 46
+utilizadores 46
+comprimento 46
+internet 46
+completo 46
+obtido 46
+ignorada 46
+maiúsculas 46
+termina 46
+sentido 46
+volume 46
+latino 46
+logitech 46
+limites 45
+útil 45
+pequeno 45
+fornece 45
+pipe 45
+módulo 45
+gerar 45
+porque 45
+mídia 45
+gravar 45
+tarefas 45
+eof 45
+all 45
+plt 45
+delimitador 45
+correspondência 44
+novamente 44
+outra 44
+imediato 44
+dir 44
+área 44
+nas 44
+resposta 44
+vai 44
+plano 44
+set 44
+hexadecimal 44
+newline 44
+regra 44
+esperava 44
+árabe 44
+húngaro 44
+filtros 43
+restaurar 43
+tentativa 43
+si 43
+atualização 43
+captura 43
+substituir 43
+significa 43
+começa 43
+pública 43
+matriz 43
+dinâmico 43
+funções 43
+editar 43
+teclado 43
+y 43
+z 43
+lixo 43
+implementa 43
+us 43
+separada 42
+vírgulas 42
+tamanhos 42
+extra 42
+backup 42
+antiga 42
+comparar 42
+letra 42
+requerido 42
+definições 42
+exportação 42
+compilado 42
+vezes 41
+relate 41
+separados 41
+etiqueta 41
+branco 41
+deste 41
+comparação 41
+geral 41
+prioridade 41
+sobrescrever 41
+unix 41
+alterado 41
+envia 41
+car 41
+triggers 41
+duas 40
+suporta 40
+automaticamente 40
+nunca 40
+ambos 40
+binários 40
+utilize 40
+domínio 40
+especiais 40
+corrupto 40
+eua 40
+extrair 40
+desabilita 40
+rom 40
+repositório 39
+instalados 39
+fila 39
+unicode 39
+remoção 39
+sistemas 39
+aberto 39
+esse 39
+assim 39
+estrutura 39
+senão 39
+pc 39
+alinhamento 39
+ctf 39
+muitos 39
+simbólicos 39
+mover 39
+seções 39
+verifica 38
+excedido 38
+bloqueio 38
+resultado 38
+permitidos 38
+começar 38
+alguns 38
+recente 38
+quaisquer 38
+ascii 38
+reconhecida 38
+separador 38
+expr 38
+isa 38
+dwarf 38
+recursivamente 38
+microsoft 38
+disposição 38
+faixa 38
+alternativa 37
+durante 37
+atualizar 37
+criado 37
+conforme 37
+contrário 37
+inclui 37
+existir 37
+rígido 37
+efeito 37
+nº 37
+secções 37
+esgotada 37
+l 37
+rótulo 37
+demasiados 36
+atualmente 36
+busca 36
+incompatível 36
+expirou 36
+acordo 36
+resolver 36
+gzip 36
+feito 36
+válida 36
+partes 36
+obtém 36
+tabulações 36
+path 36
+args 36
+operadores 36
+pedido 36
+copia 36
+got 36
+k 36
+shadow 36
+colemak 36
+carac 36
+pesquisa 35
+decimal 35
+funciona 35
+impressão 35
+novas 35
+compatível 35
+compatibilidade 35
+principal 35
+inesperada 35
+nem 35
+comportamento 35
+minúsculas 35
+inválidos 35
+hash 35
+dinâmica 35
+segue 35
+coluna 35
+inesperadamente 35
+localizado 35
+canal 35
+incorreto 35
+integridade 34
+cadeias 34
+configurar 34
+utilizado 34
+invés 34
+continuar 34
+provavelmente 34
+esperada 34
+mostrada 34
+direita 34
+muda 34
+relocalizações 34
+folha 34
+armazenar 34
+porta 34
+letão 34
+dicionário 33
+avisos 33
+parece 33
+usadas 33
+bibliotecas 33
+montagem 33
+via 33
+parado 33
+apagar 33
+considere 33
+dependência 33
+completa 33
+positivo 33
+algoritmo 33
+expressões 33
+então 33
+seleciona 33
+desactiva 33
+array 33
+corrupta 33
+formado 33
+symbol 33
+parcial 33
+ordenação 33
+habilita 33
+vir 33
+outras 32
+bandeira 32
+dada 32
+seguido 32
+pois 32
+ver 32
+download 32
+marcação 32
+nesta 32
+protocolo 32
+r 32
+partilhado 32
+importação 32
+not 32
+output 32
+nul 32
+unidades 32
+diferenças 32
+desenho 32
+serem 31
+sido 31
+única 31
+sejam 31
+ícone 31
+combinação 31
+coincide 31
+source 31
+substitui 31
+presente 31
+manter 31
+família 31
+name 31
+detectado 31
+checksum 31
+q 31
+kb 31
+escolhe 31
+despejo 30
+bruto 30
+suportados 30
+escolha 30
+retorno 30
+manipulador 30
+desta 30
+começando 30
+regras 30
+desligar 30
+indicada 30
+mapa 30
+patch 30
+precisão 30
+região 30
+sections 30
+stdin 30
+desfazer 30
+gio 30
+removendo 30
+espec 30
+gatilhos 30
+vinculador 30
+arp 30
+actualmente 29
+deslocamento 29
+indicar 29
+confiança 29
+limpar 29
+i 29
+insira 29
+acção 29
+normalmente 29
+relação 29
+compara 29
+elementos 29
+predefinida 29
+representações 29
+control 29
+tipos 29
+vários 29
+malformado 29
+raiz 29
+actualizar 29
+dirs 29
+re 29
+máscara 29
+test 29
+negativo 29
+aplica 29
+is 29
+máx 29
+múltiplo 29
+alvos 29
+corrompida 29
+sobrepõe 29
+symbols 29
+mínimo 29
+incremento 29
+marcador 29
+codificado 29
+omite 29
+segundo 29
+português 29
+tela 29
+iniciando 29
+plug 29
+threads 28
+compactado 28
+deveria 28
+falso 28
+terminado 28
+carimbo 28
+obsoleto 28
+máxima 28
+detalhada 28
+permitidas 28
+parar 28
+agora 28
+todo 28
+incompatíveis 28
+nula 28
+activa 28
+lido 28
+debug 28
+ponteiro 28
+insn 28
+gerir 28
+duplicada 28
+mesma 28
+list 28
+iniciais 28
+metalink 28
+conectar 28
+usb 28
+curdo 28
+conflitantes 28
+operações 27
+inicia 27
+suportadas 27
+soma 27
+none 27
+desabilitado 27
+esteja 27
+reiniciar 27
+absoluto 27
+itens 27
+filhos 27
+relativo 27
+realizar 27
+específico 27
+árvore 27
+html 27
+quais 27
+virtual 27
+conclusão 27
+comuns 27
+internos 27
+if 27
+existentes 27
+armazenamento 27
+mapeado 27
+default 27
+especificador 27
+trigger 27
+habilitado 27
+espanhol 27
+registros 27
+separado 26
+abortar 26
+alterada 26
+correio 26
+transferir 26
+recebido 26
+completar 26
+resumo 26
+seleção 26
+posterior 26
+implícita 26
+relatório 26
+lida 26
+através 26
+contendo 26
+posicionais 26
+deb 26
+seguinte 26
+ambígua 26
+expansão 26
+bash 26
+ocorra 26
+interpretado 26
+stack 26
+long 26
+implementado 26
+contagem 26
+zeros 26
+contents 26
+semana 26
+anexa 26
+falhas 26
+combinar 26
+irá 26
+configurado 26
+apresentação 26
+lendo 26
+sueco 26
+japonês 26
+restantes 25
+tenta 25
+comprimidos 25
+endian 25
+credenciais 25
+dia 25
+bem 25
+programas 25
+legível 25
+saindo 25
+adicione 25
+version 25
+localizações 25
+fornecer 25
+exibir 25
+estranho 25
+tiver 25
+coloca 25
+return 25
+v 25
+mapeamento 25
+sym 25
+resolução 25
+h 25
+agrupamento 25
+trabalhos 25
+conffile 25
+jobserver 25
+barramento 25
+intl 25
+válidas 24
+curta 24
+túnel 24
+finlandês 24
+arm 24
+similar 24
+talvez 24
+várias 24
+desculpe 24
+autor 24
+carregado 24
+tornar 24
+removidos 24
+quer 24
+destaque 24
+corpo 24
+formatação 24
+plataforma 24
+genérico 24
+acrescentar 24
+módulos 24
+leva 24
+desactivar 24
+atribuição 24
+estatísticas 24
+indica 24
+getopts 24
+correspondentes 24
+análise 24
+hex 24
+indefinido 24
+psect 24
+dinâmicas 24
+ramo 24
+ibm 24
+int 24
+assume 24
+files 24
+quebra 24
+truncar 24
+especificadas 24
+expiração 24
+stderr 24
+ordena 24
+empacotado 24
+orig 24
+apl 24
+hewlett 24
+expira 23
+possíveis 23
+lzma 23
+compilar 23
+tradução 23
+hardware 23
+desbloquear 23
+token 23
+imediatamente 23
+escolher 23
+deseja 23
+listas 23
+pequena 23
+exclusivas 23
+garantia 23
+pontos 23
+note 23
+especificou 23
+comentário 23
+web 23
+projeto 23
+criando 23
+humanos 23
+novos 23
+actualizações 23
+terminada 23
+atribuir 23
+inexistente 23
+vi 23
+fixa 23
+indicado 23
+linked 23
+extensões 23
+vs 23
+atraso 23
+word 23
+membros 23
+avisa 23
+buffers 23
+makefile 23
+baixar 23
+soquete 23
+stubs 23
+obrigatórios 22
+longas 22
+omitido 22
+sobrescrita 22
+buscar 22
+aplicações 22
+torna 22
+mutuamente 22
+sob 22
+alias 22
+disso 22
+tags 22
+text 22
+aparecer 22
+ftp 22
+super 22
+vazios 22
+privilégios 22
+solicitou 22
+exportar 22
+popd 22
+dígito 22
+além 22
+especificações 22
+iguais 22
+loop 22
+alcance 22
+toc 22
+anteriores 22
+carga 22
+precedente 22
+alternativo 22
+information 22
+or 22
+tab 22
+exclusão 22
+excluir 22
+gestão 22
+arquitetura 22
+tcb 22
+openoffice 22
+azerty 22
+qwertz 22
+cordless 22
+executando 22
+exige 22
+descompressão 21
+lidos 21
+automática 21
+sufixos 21
+nis 21
+aliás 21
+modificar 21
+primário 21
+selecionado 21
+idioma 21
+verifique 21
+converte 21
+so 21
+iso 21
+cor 21
+preencher 21
+errada 21
+ip 21
+eliminar 21
+definidos 21
+aqui 21
+houver 21
+enviado 21
+interrupção 21
+mata 21
+kill 21
+dll 21
+lto 21
+format 21
+preserva 21
+incluir 21
+unit 21
+específicas 21
+flags 21
+inode 21
+taxa 21
+unido 21
+adicional 21
+openpgp 21
+cálculo 21
+gravação 21
+senhas 21
+turco 21
+sérvio 21
+esperando 21
+estouro 21
+gstreamer 21
+planilha 21
+cscope 21
+literal 20
+exceder 20
+permissão 20
+perfil 20
+inicializar 20
+estes 20
+seguro 20
+ecrã 20
+indisponível 20
+passwd 20
+oferece 20
+lançamento 20
+defina 20
+listados 20
+opcionais 20
+transferência 20
+exclusivo 20
+núcleo 20
+ciclo 20
+separadas 20
+aritmética 20
+avaliação 20
+chamar 20
+executados 20
+exceto 20
+chamadas 20
+globais 20
+reportar 20
+relata 20
+escapes 20
+end 20
+def 20
+longos 20
+ocorrência 20
+união 20
+ordenar 20
+conflitos 20
+offset 20
+make 20
+locate 20
+horas 20
+próximo 20
+lt 20
+pro 20
+inibição 20
+cdx 20
+filipino 20
+italiano 20
+baixando 20
+pessoal 20
+nfa 20
+tenha 19
+sim 19
+especifique 19
+sinalizadores 19
+escrevendo 19
+propriedades 19
+atualizado 19
+gestor 19
+copyright 19
+alguma 19
+formatos 19
+python 19
+recentes 19
+conhecido 19
+org 19
+superior 19
+inicialização 19
+referencia 19
+estava 19
+obsoleta 19
+var 19
+segunda 19
+suspensão 19
+interpreta 19
+duplicar 19
+build 19
+insuficiente 19
+unir 19
+program 19
+nativo 19
+coff 19
+conteúdos 19
+add 19
+rc 19
+prematuro 19
+mb 19
+alinha 19
+sobrescreve 19
+progresso 19
+montado 19
+realocação 19
+requisição 19
+obtendo 19
+contêiner 19
+salvar 19
+redirecionamento 19
+vinculação 19
+realocações 19
+bruta 19
+passado 18
+mínima 18
+raw 18
+omissão 18
+cancelar 18
+algum 18
+monitor 18
+objetos 18
+meio 18
+ferramenta 18
+free 18
+evitar 18
+pertence 18
+user 18
+construir 18
+referenciado 18
+gerado 18
+gráfico 18
+limita 18
+exec 18
+próprio 18
+associação 18
+virtuais 18
+indique 18
+cujo 18
+cc 18
+ocorrer 18
+níveis 18
+substituído 18
+modificado 18
+morto 18
+volta 18
+macro 18
+sequências 18
+ambas 18
+interpretação 18
+vma 18
+rsrc 18
+stub 18
+emitir 18
+double 18
+dynamic 18
+out 18
+nó 18
+removida 18
+bfd 18
+truncado 18
+criada 18
+from 18
+and 18
+implica 18
+fstat 18
+maintainer 18
+privada 18
+gt 18
+esquemas 18
+sockets 18
+fechado 18
+servidores 18
+caixa 18`,
+	},
+}
@@ -0,0 +1,145 @@
+package buttifier
+
+import (
+	"testing"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	resultMap := map[string]string{
+		"what are you doing with the stream tonight":      "en",
+		"no sé qué está pasando con el directo de hoy":    "es",
+		"não sei o que está acontecendo com a live":       "pt",
+		"ich weiß nicht was heute mit dem stream los ist": "de",
+	}
+	for sentence, expected := range resultMap {
+		actual, confidence := DetectLanguage(sentence)
+		if expected != actual {
+			t.Errorf("expected %s => %s, got %s (%.2f)", sentence, expected, actual, confidence)
+		}
+	}
+}
+
+func TestDetectLanguageCandidates(t *testing.T) {
+	language, confidence := DetectLanguage("what are you doing with the stream tonight", "en", "es")
+	if language != "en" || confidence < 0.99 {
+		t.Errorf("expected en with a high confidence, got %s (%.2f)", language, confidence)
+	}
+
+	// text in a language that isn't a candidate, or in none at all, gets a low
+	// confidence even when there's a single candidate
+	resultMap := map[string][]string{
+		"não sei o que está acontecendo":                    {"en", "es"},
+		"ich weiß nicht was heute mit dem stream los ist":   {"en"},
+		"xqzv brrrt kkkk":                                   {"en"},
+		"non so cosa sta succedendo con la diretta di oggi": {"en", "it"},
+	}
+	for text, candidates := range resultMap {
+		if language, confidence := DetectLanguage(text, candidates...); confidence >= 0.5 {
+			t.Errorf("expected %s to have a low confidence among %v, got %s (%.2f)", text, candidates, language, confidence)
+		}
+	}
+
+	if language, _ := DetectLanguage("1234 :)"); language != "" {
+		t.Errorf("expected no language for text without letters, got %s", language)
+	}
+}
+
+func TestButtifySentenceDetectsLanguage(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.RandSource = UnitTestRandSource{}
+	if err := b.AddLanguage("es"); err != nil {
+		t.Fatal(err)
+	}
+	b.LanguageDetection = DetectPerSentence
+
+	words := b.HyphenateSentence("el muchacho está pasando por la calle")
+	for _, word := range words {
+		if word.Language != "es" {
+			t.Errorf("expected %s to be hyphenated as es, got %q", word.Word, word.Language)
+		}
+	}
+
+	b.DetectionThreshold = 1.1
+	sentence := "el muchacho está pasando por la calle"
+	if actual := b.ButtifySentence(sentence); actual != sentence {
+		t.Errorf("expected sentence below the threshold to be left alone, got %s", actual)
+	}
+}
+
+func TestButtifySentenceDetectionThreshold(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.RandSource = UnitTestRandSource{}
+	b.ButtificationRate = 1
+	b.LanguageDetection = DetectPerSentence
+	b.DetectionThreshold = 0.99
+
+	sentence := "ich weiß nicht was heute mit dem stream los ist"
+	if actual := b.ButtifySentence(sentence); actual != sentence {
+		t.Errorf("expected german with only en loaded to be left alone, got %s", actual)
+	}
+	if actual := b.ButtifySentence("what are you doing"); actual == "what are you doing" {
+		t.Errorf("expected english to be buttified")
+	}
+
+	// a language without a profile can't be detected, but the others still are
+	RegisterSyllabifier("test-noprofile", PhoneticSyllabifier{})
+	if err := b.AddLanguage("test-noprofile"); err != nil {
+		t.Fatal(err)
+	}
+	if actual := b.ButtifySentence("what are you doing"); actual == "what are you doing" {
+		t.Errorf("expected english to be buttified with a language without a profile loaded")
+	}
+	sentence = "non so cosa sta succedendo con la diretta di oggi"
+	if actual := b.ButtifySentence(sentence); actual != sentence {
+		t.Errorf("expected text in the language without a profile to be left alone, got %s", actual)
+	}
+}
+
+func TestDetectLanguageChat(t *testing.T) {
+	type testCase struct {
+		text       string
+		candidates []string
+		expected   string
+	}
+	testCases := []testCase{
+		{"hello", []string{"en"}, "en"},
+		{"lol", []string{"en"}, "en"},
+		{"pog", []string{"en"}, "en"},
+		{"gg", []string{"en"}, "en"},
+		{"hello chat", []string{"en"}, "en"},
+		{"hello", []string{"en", "es"}, "en"},
+		{"lol", []string{"en", "es"}, "en"},
+		{"hola", []string{"en", "es"}, "es"},
+		{"boa noite", []string{"en", "pt"}, "pt"},
+		{"danke", []string{"en", "de"}, "de"},
+	}
+	for _, tc := range testCases {
+		language, confidence := DetectLanguage(tc.text, tc.candidates...)
+		if language != tc.expected || confidence < 0.6 {
+			t.Errorf("expected %s among %v => %s, got %s (%.2f)", tc.text, tc.candidates, tc.expected, language, confidence)
+		}
+	}
+}
+
+func TestButtifyShortMessagesDetectsLanguage(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.RandSource = UnitTestRandSource{}
+	b.ButtificationRate = 1
+	b.LanguageDetection = DetectPerSentence
+	b.DetectionThreshold = 0.6
+
+	for _, message := range []string{"hello", "lol", "pog", "hello chat"} {
+		if actual := b.ButtifySentence(message); actual == message {
+			t.Errorf("expected %s to be buttified", message)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package buttifier
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/speedata/hyphenation"
+)
+
+var ErrUnknownLanguage = errors.New("buttifier: unknown language")
+
+// the language used by New
+const DefaultLanguage = "en"
+
+// pattern sets shipped with the package, keyed by language code. each one is parsed
+// the first time a Buttifier loads it and then shared by every Buttifier, so only
+// the first New for a language pays for parsing.
+// unlike typesetting, every syllable may be replaced, so english keeps single letter
+// syllables. the other pattern sets would split off consonants like "S-tra-ße" or
+// "p-si-co-lo-gia" without a leftmin
+var bundledLanguages = map[string]func() (Syllabifier, error){
+	"en": bundledLanguage(HyphenatorData, 0, 0),
+	"pt": bundledLanguage(HyphenatorDataPT, 2, 0),
+	"es": bundledLanguage(HyphenatorDataES, 2, 0),
+	"de": bundledLanguage(HyphenatorDataDE, 2, 2),
+}
+
+// leftmin and rightmin are the fewest letters kept before the first and after the last break
+func bundledLanguage(data string, leftmin int, rightmin int) func() (Syllabifier, error) {
+	return sync.OnceValues(func() (Syllabifier, error) {
+		// never modified after parsing, so it's safe to share between goroutines
+		hyph, err := hyphenation.New(strings.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		// speedata/hyphenation keeps one more letter than Leftmin at the start
+		hyph.Leftmin = max(leftmin-1, 0)
+		hyph.Rightmin = rightmin
+		return TeXSyllabifier{Patterns: hyph}, nil
+	})
+}
+
+var (
+	languagesMu sync.RWMutex
+	// syllabifiers added with RegisterLanguage, these take precedence over bundled ones
+	registeredLanguages = map[string]Syllabifier{}
+)
+
+// parses TeX patterns and exceptions from r, see ParsePatterns, and makes them
+// available to every Buttifier under code
+func RegisterLanguage(code string, r io.Reader) error {
+	syllabifier, err := ParsePatterns(r)
+	if err != nil {
+		return fmt.Errorf("buttifier: parsing patterns for %q: %w", code, err)
+	}
+	RegisterSyllabifier(code, syllabifier)
+	return nil
+}
+
+// same as RegisterLanguage, reading every file at paths, like a
+// hyph-*.pat.txt file and its hyph-*.hyp.txt exceptions
+func RegisterLanguageFiles(code string, paths ...string) error {
+	syllabifier, err := LoadPatternFiles(paths...)
+	if err != nil {
+		return fmt.Errorf("buttifier: loading patterns for %q: %w", code, err)
+	}
+	RegisterSyllabifier(code, syllabifier)
+	return nil
+}
+
+// same as RegisterLanguageFiles, reading the files with names from fsys
+func RegisterLanguageFS(code string, fsys fs.FS, names ...string) error {
+	syllabifier, err := LoadPatternsFS(fsys, names...)
+	if err != nil {
+		return fmt.Errorf("buttifier: loading patterns for %q: %w", code, err)
+	}
+	RegisterSyllabifier(code, syllabifier)
+	return nil
+}
+
+// makes an already parsed pattern set available to every Buttifier under code
+func RegisterHyphenator(code string, hyph *hyphenation.Lang) {
+	RegisterSyllabifier(code, TeXSyllabifier{Patterns: hyph})
+}
+
+// makes syllabifier available to every Buttifier under code
+func RegisterSyllabifier(code string, syllabifier Syllabifier) {
+	languagesMu.Lock()
+	defer languagesMu.Unlock()
+	registeredLanguages[normalizeLanguageCode(code)] = syllabifier
+}
+
+// returns the sorted codes of every bundled and registered language
+func Languages() []string {
+	languagesMu.RLock()
+	defer languagesMu.RUnlock()
+
+	var codes []string
+	for code := range bundledLanguages {
+		codes = append(codes, code)
+	}
+	for code := range registeredLanguages {
+		if _, ok := bundledLanguages[code]; !ok {
+			codes = append(codes, code)
+		}
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// "pt_BR" -> "pt-br"
+func normalizeLanguageCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "_", "-"))
+}
+
+// finds the pattern set for code, falling back to the base language
+// when a regional variant like "pt-br" is not available
+func loadLanguage(code string) (Syllabifier, error) {
+	code = normalizeLanguageCode(code)
+	candidates := []string{code}
+	if base, _, found := strings.Cut(code, "-"); found {
+		candidates = append(candidates, base)
+	}
+
+	for _, candidate := range candidates {
+		languagesMu.RLock()
+		syllabifier, ok := registeredLanguages[candidate]
+		languagesMu.RUnlock()
+		if ok {
+			return syllabifier, nil
+		}
+
+		if load, ok := bundledLanguages[candidate]; ok {
+			return load()
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrUnknownLanguage, code)
+}
+
+// returns the language HyphenateWord and ButtifySentence use
+func (b *Buttifier) Language() string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.language
+}
+
+// switches the language used by HyphenateWord, loading its patterns if needed
+func (b *Buttifier) SetLanguage(code string) error {
+	code = normalizeLanguageCode(code)
+	if err := b.AddLanguage(code); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.language = code
+	return nil
+}
+
+// loads the patterns for code into b without making it the current language.
+// with WithSyllabifier no patterns are needed, so any code is accepted
+func (b *Buttifier) AddLanguage(code string) error {
+	code = normalizeLanguageCode(code)
+	b.mu.RLock()
+	_, ok := b.syllabifiers[code]
+	syllabifier := b.syllabifier
+	b.mu.RUnlock()
+	if ok {
+		return nil
+	}
+
+	if syllabifier == nil {
+		var err error
+		if syllabifier, err = loadLanguage(code); err != nil {
+			return err
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// copy instead of modifying, running calls may still be reading the old map
+	syllabifiers := maps.Clone(b.syllabifiers)
+	syllabifiers[code] = syllabifier
+	b.syllabifiers = syllabifiers
+	return nil
+}
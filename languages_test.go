@@ -0,0 +1,128 @@
+package buttifier
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func joinSyllables(word *HyphenatedWord) string {
+	syllables := []string{}
+	for _, syllable := range word.Syllables {
+		syllables = append(syllables, syllable.Letters)
+	}
+	return strings.Join(syllables, "-")
+}
+
+func TestNewWithLanguage(t *testing.T) {
+	expectedResults := map[string]map[string]string{
+		"pt": {
+			"brasileiro": "bra-si-lei-ro",
+			"trabalho":   "tra-ba-lho",
+			"carro":      "car-ro",
+			"psicologia": "psi-co-lo-gia",
+		},
+		"es": {
+			"perro":      "pe-rro",
+			"muchacho":   "mu-cha-cho",
+			"computador": "com-pu-ta-dor",
+			"psicología": "psi-co-lo-gía",
+		},
+		"de": {
+			"Zucker":        "Zu-cker",
+			"wunderbar":     "wun-der-bar",
+			"Straße":        "Stra-ße",
+			"unglaublich":   "un-glaub-lich",
+			"Geschichte":    "Ge-schich-te",
+			"Apfel":         "Ap-fel",
+			"Katze":         "Kat-ze",
+			"Glück":         "Glück",
+			"Schwester":     "Schwes-ter",
+			"schlafen":      "schla-fen",
+			"schreiben":     "schrei-ben",
+			"Schnee":        "Schnee",
+			"Schmerz":       "Schmerz",
+			"Schmetterling": "Schmet-ter-ling",
+			"beschreiben":   "be-schrei-ben",
+			"menschlich":    "mensch-lich",
+		},
+	}
+	for language, words := range expectedResults {
+		b, err := NewWithLanguage(language)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Language() != language {
+			t.Errorf("expected language %s, got %s", language, b.Language())
+		}
+		for word, expected := range words {
+			actual := joinSyllables(b.HyphenateWord(word))
+			if expected != actual {
+				t.Errorf("%s: expected %s => %s, got %s", language, word, expected, actual)
+			}
+		}
+	}
+}
+
+func TestNewWithLanguageRegionalFallback(t *testing.T) {
+	b, err := NewWithLanguage("pt_BR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual := joinSyllables(b.HyphenateWord("trabalho")); actual != "tra-ba-lho" {
+		t.Errorf("expected tra-ba-lho, got %s", actual)
+	}
+}
+
+func TestNewWithUnknownLanguage(t *testing.T) {
+	_, err := NewWithLanguage("xx")
+	if !errors.Is(err, ErrUnknownLanguage) {
+		t.Errorf("expected ErrUnknownLanguage, got %v", err)
+	}
+}
+
+func TestRegisterLanguage(t *testing.T) {
+	// break before every "ba"
+	err := RegisterLanguage("test-b", strings.NewReader("1ba"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Contains(Languages(), "test-b") {
+		t.Errorf("expected test-b in %v", Languages())
+	}
+
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.SetLanguage("test-b"); err != nil {
+		t.Fatal(err)
+	}
+	if actual := joinSyllables(b.HyphenateWord("abababa")); actual != "a-ba-ba-ba" {
+		t.Errorf("expected a-ba-ba-ba, got %s", actual)
+	}
+}
+
+func TestBundledLanguagesShared(t *testing.T) {
+	first, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.syllabifiers["en"].(TeXSyllabifier).Patterns != second.syllabifiers["en"].(TeXSyllabifier).Patterns {
+		t.Error("expected every Buttifier to share the parsed patterns")
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := New(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package buttifier
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// inflectional suffixes kept by Config.KeepSuffixes, keyed by base language code.
+// longer suffixes are tried first
+var (
+	suffixesMu sync.RWMutex
+	suffixes   = map[string][]string{
+		"en": {"'s", "’s", "ing", "ed", "es", "er", "ly", "s"},
+		"pt": {"mente", "ando", "endo", "indo", "ado", "ada", "ido", "ida", "ões", "es", "s"},
+		"es": {"mente", "iendo", "ando", "ado", "ada", "ido", "ida", "es", "s"},
+		"de": {"ungen", "ung", "en", "er", "es", "s"},
+	}
+)
+
+// words that end like a suffix without being inflected, keyed by base language code
+var uninflected = map[string]map[string]bool{
+	"en": setOf("after", "another", "answer", "brother", "either", "father", "hundred",
+		"matter", "member", "mother", "naked", "neither", "number", "order", "other", "over",
+		"rather", "sacred", "sister", "summer", "together", "under", "whether", "wicked",
+		"winter", "wonder"),
+}
+
+func setOf(words ...string) map[string]bool {
+	set := map[string]bool{}
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// words need at least this many runes left once the suffix is removed
+const minStemLength = 3
+
+// sets the suffixes Config.KeepSuffixes keeps for code, replacing any previous ones
+func RegisterSuffixes(code string, list ...string) {
+	suffixesMu.Lock()
+	defer suffixesMu.Unlock()
+	suffixes[normalizeLanguageCode(code)] = slices.Clone(list)
+}
+
+// the longest known suffix of word in language, "" if there is none
+func findSuffix(word string, language string) string {
+	suffixesMu.RLock()
+	list, ok := suffixes[language]
+	if !ok {
+		base, _, _ := strings.Cut(language, "-")
+		list = suffixes[base]
+	}
+	suffixesMu.RUnlock()
+
+	lower := strings.ToLower(word)
+	base, _, _ := strings.Cut(language, "-")
+	if uninflected[base][lower] {
+		return ""
+	}
+	longest := ""
+	for _, suffix := range list {
+		if len(suffix) > len(longest) && strings.HasSuffix(lower, suffix) &&
+			isStem(strings.TrimSuffix(lower, suffix), suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
+
+// whether stem is what's left of an inflected word once suffix is removed, rather
+// than a word that just happens to end like the suffix, like "kiss", "this" or "paper"
+func isStem(stem string, suffix string) bool {
+	if utf8.RuneCountInString(stem) < minStemLength {
+		return false
+	}
+	syllables := vowelGroups(stem)
+	switch {
+	case syllables == 0:
+		return false
+	case suffix == "s":
+		last, _ := utf8.DecodeLastRuneInString(stem)
+		return last != 's' && last != 'u' && last != 'i'
+	case suffix == "ed":
+		// "need" and "speed", a stem ending in e only takes a "d" like in "liked"
+		return !strings.HasSuffix(stem, "e")
+	case suffix == "er":
+		// "paper" and "never", a short vowel before a single consonant would be
+		// doubled by the suffix, like in "bigger"
+		return syllables > 1 || !endsInShortVowel(stem)
+	}
+	return true
+}
+
+// whether stem ends in a single vowel followed by a single consonant, like "pap"
+func endsInShortVowel(stem string) bool {
+	runes := []rune(stem)
+	n := len(runes)
+	isVowel := func(r rune) bool { return strings.ContainsRune(vowelLetters, r) }
+	return n >= 2 && !isVowel(runes[n-1]) && isVowel(runes[n-2]) && (n == 2 || !isVowel(runes[n-3]))
+}
+
+// roughly the number of syllables in a lowercase word
+func vowelGroups(word string) int {
+	groups := 0
+	inGroup := false
+	for _, r := range word {
+		vowel := strings.ContainsRune(vowelLetters, r)
+		if vowel && !inGroup {
+			groups++
+		}
+		inGroup = vowel
+	}
+	return groups
+}
+
+// splits a known suffix off word into its own final syllable, which is never replaced,
+// so "developers" buttifies to "develbutts" instead of "develbuttbutt".
+// what's left of a syllable cut by the suffix is merged into the previous syllable
+// when it's a single rune, like the second "n" in "run-ning"
+func keepSuffix(word *HyphenatedWord) {
+	suffix := findSuffix(word.Word, word.Language)
+	if suffix == "" {
+		return
+	}
+	runes := []rune(word.Word)
+	suffixStart := len(runes) - utf8.RuneCountInString(suffix)
+
+	var syllables []*Syllable
+	for _, current := range word.Syllables {
+		if current.IdxEnd <= suffixStart {
+			syllables = append(syllables, current)
+			continue
+		}
+		if current.IdxStart < suffixStart {
+			stem := &Syllable{
+				Letters:  string(runes[current.IdxStart:suffixStart]),
+				IdxStart: current.IdxStart,
+				IdxEnd:   suffixStart,
+			}
+			if stem.IdxEnd-stem.IdxStart == 1 && len(syllables) > 0 {
+				previous := syllables[len(syllables)-1]
+				syllables[len(syllables)-1] = &Syllable{
+					Letters:  previous.Letters + stem.Letters,
+					IdxStart: previous.IdxStart,
+					IdxEnd:   suffixStart,
+				}
+			} else {
+				syllables = append(syllables, stem)
+			}
+		}
+		break
+	}
+	syllables = append(syllables, &Syllable{
+		Letters:  string(runes[suffixStart:]),
+		IdxStart: suffixStart,
+		IdxEnd:   len(runes),
+	})
+
+	word.Syllables = syllables
+	word.Breakpoints = word.Breakpoints[:0]
+	for _, current := range syllables {
+		word.Breakpoints = append(word.Breakpoints, current.IdxEnd)
+	}
+	word.keptSuffix = 1
+}
@@ -0,0 +1,83 @@
+package buttifier
+
+import (
+	"testing"
+)
+
+func TestKeepSuffixes(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.KeepSuffixes = true
+
+	resultMap := map[string]string{
+		"developers": "buttbuttbuttbutts",
+		"running":    "butting",
+		"computer":   "buttbutter",
+		"someone's":  "buttbutt's",
+		"quickly":    "buttly",
+		"DEVELOPERS": "BUTTBUTTBUTTBUTTS",
+		"successful": "buttbuttbutt",
+		"is":         "butt",
+		"partners":   "buttbutts",
+		"walked":     "butted",
+		"played":     "butted",
+		"called":     "butted",
+		"bigger":     "butter",
+		// not inflected, the ending is buttified like the rest of the word
+		"this":   "butt",
+		"kiss":   "butt",
+		"paper":  "buttbutt",
+		"never":  "buttbuttbutt",
+		"crisis": "buttbutt",
+	}
+	for word, expected := range resultMap {
+		actual, _ := b.ButtifyWord(word)
+		if expected != actual {
+			t.Errorf("expected %s => %s, got %s => %s", word, expected, word, actual)
+		}
+	}
+}
+
+func TestKeepSuffixesSentence(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.KeepSuffixes = true
+	b.ButtificationRate = 1
+
+	result := b.Buttify("grinding partners")
+	if result.Output != "butting buttbutts" {
+		t.Errorf("expected butting buttbutts, got %s", result.Output)
+	}
+	for _, edit := range result.Edits {
+		if edit.Original == "ing" || edit.Original == "s" {
+			t.Errorf("expected the suffix to never be replaced, got %+v", edit)
+		}
+	}
+}
+
+func TestKeepSuffixesOtherLanguages(t *testing.T) {
+	resultMap := map[string]map[string]string{
+		"pt": {"trabalhando": "buttbuttbuttando", "casas": "buttbutts"},
+		"es": {"rápidamente": "buttbuttbuttmente"},
+	}
+	for language, words := range resultMap {
+		b, err := NewWithLanguage(language)
+		b.RandSource = UnitTestRandSource{}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.KeepSuffixes = true
+		for word, expected := range words {
+			actual, _ := b.ButtifyWord(word)
+			if expected != actual {
+				t.Errorf("%s: expected %s => %s, got %s => %s", language, word, expected, word, actual)
+			}
+		}
+	}
+}
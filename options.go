@@ -0,0 +1,53 @@
+package buttifier
+
+import "math/rand/v2"
+
+// configures a Buttifier created with New
+type Option func(*newOptions)
+
+type newOptions struct {
+	language    string
+	languages   []string
+	syllabifier Syllabifier
+	randSource  rand.Source
+	cacheSize   int
+}
+
+// the language HyphenateWord and ButtifySentence use, DefaultLanguage by default
+func WithLanguage(code string) Option {
+	return func(o *newOptions) {
+		o.language = code
+	}
+}
+
+// also loads these languages, for LanguageDetection
+func WithLanguages(codes ...string) Option {
+	return func(o *newOptions) {
+		o.languages = append(o.languages, codes...)
+	}
+}
+
+// splits words of every language with syllabifier instead of TeX patterns
+//
+//	New(WithSyllabifier(PhoneticSyllabifier{SilentFinalE: true}))
+func WithSyllabifier(syllabifier Syllabifier) Option {
+	return func(o *newOptions) {
+		o.syllabifier = syllabifier
+	}
+}
+
+// same as setting RandSource before using the Buttifier
+func WithRandSource(source rand.Source) Option {
+	return func(o *newOptions) {
+		o.randSource = source
+	}
+}
+
+// remembers how the last size distinct words were split, so repeated words skip the
+// syllabifier. words are cached in lowercase, so a custom Syllabifier must split words
+// the same way regardless of case. see Buttifier.CacheStats for how well it works
+func WithCache(size int) Option {
+	return func(o *newOptions) {
+		o.cacheSize = size
+	}
+}
@@ -0,0 +1,146 @@
+package buttifier
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/speedata/hyphenation"
+)
+
+// parses hyph-utf8 style files into a TeXSyllabifier. every reader may be a
+// hyph-*.tex file with \patterns{} and \hyphenation{} blocks, a hyph-*.pat.txt file
+// with one pattern per line or a hyph-*.hyp.txt file with one exception word per line,
+// where a word without hyphens is never split. % starts a comment
+func ParsePatterns(readers ...io.Reader) (TeXSyllabifier, error) {
+	var patterns []string
+	exceptions := map[string][]int{}
+	for _, r := range readers {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return TeXSyllabifier{}, err
+		}
+		text := stripTeXComments(string(data))
+
+		patternBlocks := texBlocks(text, `\patterns`)
+		exceptionBlocks := texBlocks(text, `\hyphenation`)
+		if patternBlocks == nil && exceptionBlocks == nil {
+			// a plain list. every pattern has a digit, anything else is an exception
+			for _, field := range strings.Fields(text) {
+				if strings.ContainsFunc(field, unicode.IsDigit) {
+					patternBlocks = append(patternBlocks, field)
+				} else {
+					exceptionBlocks = append(exceptionBlocks, field)
+				}
+			}
+		}
+
+		for _, block := range patternBlocks {
+			patterns = append(patterns, strings.Fields(block)...)
+		}
+		for _, block := range exceptionBlocks {
+			for _, exception := range strings.Fields(block) {
+				word, breakpoints := parseException(exception)
+				exceptions[word] = breakpoints
+			}
+		}
+	}
+
+	syllabifier := TeXSyllabifier{Exceptions: exceptions}
+	if len(patterns) > 0 {
+		hyph, err := hyphenation.New(strings.NewReader(strings.Join(patterns, "\n")))
+		if err != nil {
+			return TeXSyllabifier{}, err
+		}
+		syllabifier.Patterns = hyph
+	}
+	return syllabifier, nil
+}
+
+// same as ParsePatterns, reading the files at paths
+func LoadPatternFiles(paths ...string) (TeXSyllabifier, error) {
+	return LoadPatternsFS(osFS{}, paths...)
+}
+
+// same as ParsePatterns, reading the files with names from fsys
+func LoadPatternsFS(fsys fs.FS, names ...string) (TeXSyllabifier, error) {
+	var readers []io.Reader
+	for _, name := range names {
+		file, err := fsys.Open(name)
+		if err != nil {
+			return TeXSyllabifier{}, err
+		}
+		defer file.Close()
+		readers = append(readers, bufio.NewReader(file))
+	}
+	syllabifier, err := ParsePatterns(readers...)
+	if err != nil {
+		return TeXSyllabifier{}, fmt.Errorf("%s: %w", strings.Join(names, ", "), err)
+	}
+	return syllabifier, nil
+}
+
+// opens paths as they are, which os.DirFS can't do for absolute or ../ paths
+type osFS struct{}
+
+func (osFS) Open(name string) (fs.File, error) {
+	return os.Open(name)
+}
+
+func stripTeXComments(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if idx := strings.IndexByte(line, '%'); idx >= 0 {
+			lines[i] = line[:idx]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// the contents of every command{...} in text, nil if there are none
+func texBlocks(text string, command string) []string {
+	var blocks []string
+	for {
+		idx := strings.Index(text, command)
+		if idx < 0 {
+			return blocks
+		}
+		rest := strings.TrimLeftFunc(text[idx+len(command):], unicode.IsSpace)
+		if !strings.HasPrefix(rest, "{") {
+			// another command that starts the same, like \patternsfoo
+			text = text[idx+len(command):]
+			continue
+		}
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			end = len(rest)
+		}
+		blocks = append(blocks, rest[1:end])
+		text = rest[min(end+1, len(rest)):]
+	}
+}
+
+// "ta-ble" -> "table", [2 5]
+func parseException(exception string) (string, []int) {
+	var word strings.Builder
+	var breakpoints []int
+	length := 0
+	for _, r := range strings.ToLower(exception) {
+		if r == '-' {
+			if length > 0 && (len(breakpoints) == 0 || breakpoints[len(breakpoints)-1] != length) {
+				breakpoints = append(breakpoints, length)
+			}
+			continue
+		}
+		word.WriteRune(r)
+		length++
+	}
+	if len(breakpoints) == 0 || breakpoints[len(breakpoints)-1] != length {
+		breakpoints = append(breakpoints, length)
+	}
+	return word.String(), breakpoints
+}
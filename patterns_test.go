@@ -0,0 +1,134 @@
+package buttifier
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const testPatternsTeX = `% hyph-test.tex
+\message{test patterns}
+\patterns{ % break before every "ba"
+1ba
+}
+\hyphenation{
+ta-ble
+AB-A-BA % overrides the patterns
+}
+`
+
+func TestParsePatternsTeX(t *testing.T) {
+	syllabifier, err := ParsePatterns(strings.NewReader(testPatternsTeX))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resultMap := map[string][]int{
+		"abababa": {1, 3, 5, 7},
+		"table":   {2, 5},
+		"Table":   {2, 5},
+		"ababa":   {2, 3, 5},
+	}
+	for word, expected := range resultMap {
+		if actual := syllabifier.Syllabify(word); !slices.Equal(actual, expected) {
+			t.Errorf("expected %s => %v, got %v", word, expected, actual)
+		}
+	}
+}
+
+func TestParsePatternsUnhyphenatedExceptions(t *testing.T) {
+	// "1se" would split "pre-sent", the exception keeps it whole
+	inputs := map[string][]string{
+		"plain list": {"1se\n", "present\nta-ble\n"},
+		"tex":        {"\\patterns{1se}\n\\hyphenation{present ta-ble}\n"},
+	}
+	for name, texts := range inputs {
+		var readers []io.Reader
+		for _, text := range texts {
+			readers = append(readers, strings.NewReader(text))
+		}
+		syllabifier, err := ParsePatterns(readers...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resultMap := map[string][]int{
+			"present": {7},
+			"table":   {2, 5},
+			"closet":  {3, 6},
+		}
+		for word, expected := range resultMap {
+			if actual := syllabifier.Syllabify(word); !slices.Equal(actual, expected) {
+				t.Errorf("%s: expected %s => %v, got %v", name, word, expected, actual)
+			}
+		}
+	}
+}
+
+func TestLoadPatternsFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"hyph-test.pat.txt": {Data: []byte("1ba\n")},
+		"hyph-test.hyp.txt": {Data: []byte("ta-ble\n")},
+	}
+	if err := RegisterLanguageFS("test-fs", fsys, "hyph-test.pat.txt", "hyph-test.hyp.txt"); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewWithLanguage("test-fs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resultMap := map[string]string{
+		"abababa": "a-ba-ba-ba",
+		"Table":   "Ta-ble",
+	}
+	for word, expected := range resultMap {
+		if actual := joinSyllables(b.HyphenateWord(word)); actual != expected {
+			t.Errorf("expected %s => %s, got %s", word, expected, actual)
+		}
+	}
+
+	if _, err := LoadPatternsFS(fsys, "hyph-missing.pat.txt"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestRegisterLanguageFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hyph-test.tex")
+	if err := os.WriteFile(path, []byte(testPatternsTeX), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterLanguageFiles("test-file", path); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := NewWithLanguage("test-file")
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	if actual := b.ButtifySentence("ABABA table"); actual != "BUTTBUTTBUTT buttbutt" {
+		t.Errorf("expected BUTTBUTTBUTT buttbutt, got %s", actual)
+	}
+
+	if err := RegisterLanguageFiles("test-file", filepath.Join(t.TempDir(), "missing.tex")); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestExceptionsOnlyPatterns(t *testing.T) {
+	syllabifier, err := ParsePatterns(strings.NewReader("pog-champ\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual := syllabifier.Syllabify("pogchamp"); !slices.Equal(actual, []int{3, 8}) {
+		t.Errorf("expected [3 8], got %v", actual)
+	}
+	if actual := syllabifier.Syllabify("sadge"); !slices.Equal(actual, []int{5}) {
+		t.Errorf("expected [5], got %v", actual)
+	}
+}
@@ -0,0 +1,219 @@
+package buttifier
+
+import (
+	"strings"
+	"sync"
+)
+
+// settings that override a Buttifier's Config for a channel or user, nil fields
+// keep the value from the layer below
+type Profile struct {
+	// language code whose patterns are used, like "pt" for a brazilian channel.
+	// loaded into the Buttifier by SetChannel and SetUser
+	Language                 *string
+	ButtWord                 *string
+	ButtWords                []WeightedWord
+	WordChoice               *WordChoice
+	ButtificationProbability *float64
+	ButtificationRate        *float64
+	KeepSuffixes             *bool
+	SplitIdentifiers         *bool
+	Selection                SelectionStrategy
+	PreferStressed           *bool
+	Rounding                 *Rounding
+	MaxReplacements          *int
+	MinWords                 *int
+	MinSyllables             *int
+	MaxLength                *int
+	// stopwords depend on the channel's language
+	Exclusions *Exclusions
+	Unprotect  *TokenKind
+	// each channel usually has its own BTTV, FFZ and 7TV emotes
+	Emotes    *EmoteSet
+	ButtEmote *string
+	// like streamer and emote names
+	Dictionary *SyllableDictionary
+}
+
+// returns a pointer to v, for filling in Profile fields
+//
+//	Profile{ButtWord: Override("booty")}
+func Override[T any](v T) *T {
+	return &v
+}
+
+func (p Profile) apply(config *Config) {
+	if p.ButtWord != nil {
+		config.ButtWord = *p.ButtWord
+		// ButtWords would be used instead, unless this profile sets it too
+		config.ButtWords = nil
+	}
+	if p.ButtWords != nil {
+		config.ButtWords = p.ButtWords
+	}
+	if p.WordChoice != nil {
+		config.WordChoice = *p.WordChoice
+	}
+	if p.ButtificationProbability != nil {
+		config.ButtificationProbability = *p.ButtificationProbability
+	}
+	if p.ButtificationRate != nil {
+		config.ButtificationRate = *p.ButtificationRate
+	}
+	if p.KeepSuffixes != nil {
+		config.KeepSuffixes = *p.KeepSuffixes
+	}
+	if p.SplitIdentifiers != nil {
+		config.SplitIdentifiers = *p.SplitIdentifiers
+	}
+	if p.Selection != nil {
+		config.Selection = p.Selection
+	}
+	if p.PreferStressed != nil {
+		config.PreferStressed = *p.PreferStressed
+	}
+	if p.Rounding != nil {
+		config.Rounding = *p.Rounding
+	}
+	if p.MaxReplacements != nil {
+		config.MaxReplacements = *p.MaxReplacements
+	}
+	if p.MinWords != nil {
+		config.MinWords = *p.MinWords
+	}
+	if p.MinSyllables != nil {
+		config.MinSyllables = *p.MinSyllables
+	}
+	if p.MaxLength != nil {
+		config.MaxLength = *p.MaxLength
+	}
+	if p.Exclusions != nil {
+		config.Exclusions = *p.Exclusions
+	}
+	if p.Unprotect != nil {
+		config.Unprotect = *p.Unprotect
+	}
+	if p.Emotes != nil {
+		config.Emotes = p.Emotes
+	}
+	if p.ButtEmote != nil {
+		config.ButtEmote = *p.ButtEmote
+	}
+	if p.Dictionary != nil {
+		config.Dictionary = p.Dictionary
+	}
+}
+
+// per-channel and per-user settings on top of a shared Buttifier, so every
+// profile reuses the same hyphenators. the Buttifier's Config is the default,
+// then the channel profile is applied, then the user profile.
+// safe for concurrent use
+type Profiles struct {
+	buttifier *Buttifier
+
+	mu       sync.RWMutex
+	channels map[string]Profile
+	users    map[string]Profile
+}
+
+func NewProfiles(b *Buttifier) *Profiles {
+	return &Profiles{
+		buttifier: b,
+		channels:  map[string]Profile{},
+		users:     map[string]Profile{},
+	}
+}
+
+// "#SomeChannel" -> "somechannel"
+func profileKey(name string) string {
+	return strings.ToLower(strings.TrimPrefix(name, "#"))
+}
+
+// fails when profile.Language can't be loaded
+func (p *Profiles) SetChannel(channel string, profile Profile) error {
+	if err := p.addLanguage(&profile); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.channels[profileKey(channel)] = profile
+	return nil
+}
+
+// fails when profile.Language can't be loaded
+func (p *Profiles) SetUser(user string, profile Profile) error {
+	if err := p.addLanguage(&profile); err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.users[profileKey(user)] = profile
+	return nil
+}
+
+// loads the profile's language into the buttifier and normalizes its code
+func (p *Profiles) addLanguage(profile *Profile) error {
+	if profile.Language == nil {
+		return nil
+	}
+	code := normalizeLanguageCode(*profile.Language)
+	if err := p.buttifier.AddLanguage(code); err != nil {
+		return err
+	}
+	profile.Language = &code
+	return nil
+}
+
+func (p *Profiles) RemoveChannel(channel string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.channels, profileKey(channel))
+}
+
+func (p *Profiles) RemoveUser(user string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.users, profileKey(user))
+}
+
+// the settings for a message from user in channel, either can be ""
+func (p *Profiles) Resolve(channel string, user string) Config {
+	return p.resolve(channel, user).config
+}
+
+// the language for a message from user in channel, either can be ""
+func (p *Profiles) ResolveLanguage(channel string, user string) string {
+	if language := p.resolve(channel, user).language; language != "" {
+		return language
+	}
+	return p.buttifier.Language()
+}
+
+func (p *Profiles) resolve(channel string, user string) *overrides {
+	o := &overrides{config: p.buttifier.Snapshot()}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, profile := range []Profile{p.channels[profileKey(channel)], p.users[profileKey(user)]} {
+		profile.apply(&o.config)
+		if profile.Language != nil {
+			o.language = *profile.Language
+		}
+	}
+	return o
+}
+
+// ButtifySentence with the settings resolved for channel and user
+func (p *Profiles) ButtifySentence(channel string, user string, sentence string) string {
+	return p.Buttify(channel, user, "", sentence).Output
+}
+
+// ButtifyMessage with the settings resolved for channel and user, messageID can be ""
+func (p *Profiles) Buttify(channel string, user string, messageID string, text string) Result {
+	return p.buttifier.buttify(p.resolve(channel, user), messageKey(messageID, text), text)
+}
+
+// MaybeButtifyMessage with the settings resolved for channel and user, messageID can be ""
+func (p *Profiles) MaybeButtify(channel string, user string, messageID string, text string) Result {
+	return p.buttifier.maybeButtify(p.resolve(channel, user), messageKey(messageID, text), text)
+}
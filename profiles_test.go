@@ -0,0 +1,184 @@
+package buttifier
+
+import (
+	"testing"
+)
+
+func TestProfilesResolve(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles := NewProfiles(b)
+	profiles.SetChannel("#SomeChannel", Profile{
+		ButtWord:          Override("booty"),
+		ButtificationRate: Override(0.5),
+	})
+	profiles.SetUser("Someone", Profile{ButtWord: Override("bum")})
+
+	type testCase struct {
+		channel, user string
+		word          string
+		rate          float64
+	}
+	testCases := []testCase{
+		{"otherchannel", "other", "butt", 0.3},
+		{"somechannel", "other", "booty", 0.5},
+		{"#somechannel", "someone", "bum", 0.5},
+		{"otherchannel", "SOMEONE", "bum", 0.3},
+	}
+	for _, tc := range testCases {
+		config := profiles.Resolve(tc.channel, tc.user)
+		if config.ButtWord != tc.word || config.ButtificationRate != tc.rate {
+			t.Errorf("%+v: got ButtWord %s and ButtificationRate %f", tc, config.ButtWord, config.ButtificationRate)
+		}
+	}
+
+	profiles.RemoveUser("someone")
+	if config := profiles.Resolve("somechannel", "someone"); config.ButtWord != "booty" {
+		t.Errorf("expected the user profile to be removed, got %s", config.ButtWord)
+	}
+}
+
+func TestProfilesButtify(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles := NewProfiles(b)
+	profiles.SetChannel("somechannel", Profile{
+		ButtWord:                 Override("booty"),
+		ButtificationProbability: Override(0.0),
+	})
+
+	if actual := profiles.ButtifySentence("somechannel", "", "grinding for partner"); actual != "bootybooty for partner" {
+		t.Errorf("expected bootybooty for partner, got %s", actual)
+	}
+	if actual := profiles.ButtifySentence("otherchannel", "", "grinding for partner"); actual != "buttbutt for partner" {
+		t.Errorf("expected buttbutt for partner, got %s", actual)
+	}
+	if result := profiles.MaybeButtify("somechannel", "", "", "grinding for partner"); result.Skipped != SkippedByProbability {
+		t.Errorf("expected the channel probability to skip the message, got %s", result.Skipped)
+	}
+	if b.ButtWord != "butt" {
+		t.Errorf("expected profiles not to change the buttifier, got %s", b.ButtWord)
+	}
+}
+
+func TestProfilesExclusions(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	profiles := NewProfiles(b)
+	profiles.SetChannel("somechannel", Profile{Exclusions: Override(Exclusions{Stopwords: true})})
+
+	if actual := profiles.ButtifySentence("somechannel", "", "grinding for the partner"); actual != "buttbutt for the buttbutt" {
+		t.Errorf("expected buttbutt for the buttbutt, got %s", actual)
+	}
+	if actual := profiles.ButtifySentence("otherchannel", "", "grinding for the partner"); actual != "buttbutt butt butt buttbutt" {
+		t.Errorf("expected buttbutt butt butt buttbutt, got %s", actual)
+	}
+}
+
+func TestProfilesUnprotect(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	profiles := NewProfiles(b)
+	profiles.SetChannel("somechannel", Profile{Unprotect: Override(TokenHashtag)})
+
+	if actual := profiles.ButtifySentence("somechannel", "", "#grinding"); actual != "#buttbutt" {
+		t.Errorf("expected #buttbutt, got %s", actual)
+	}
+	if actual := profiles.ButtifySentence("otherchannel", "", "#grinding"); actual != "#grinding" {
+		t.Errorf("expected #grinding, got %s", actual)
+	}
+}
+
+func TestProfilesPreferStressed(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	profiles := NewProfiles(b)
+	b.ButtificationRate = 0.25
+	profiles.SetChannel("somechannel", Profile{PreferStressed: Override(true)})
+
+	if actual := profiles.ButtifySentence("somechannel", "", "developer"); actual != "debuttoper" {
+		t.Errorf("expected debuttoper, got %s", actual)
+	}
+	if actual := profiles.ButtifySentence("otherchannel", "", "developer"); actual != "buttveloper" {
+		t.Errorf("expected buttveloper, got %s", actual)
+	}
+}
+
+func TestProfilesLanguage(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles := NewProfiles(b)
+	if err := profiles.SetChannel("brasil", Profile{Language: Override("pt_BR")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := profiles.SetUser("someone", Profile{Language: Override("xx")}); err == nil {
+		t.Error("expected an error for an unknown language")
+	}
+
+	if language := profiles.ResolveLanguage("brasil", ""); language != "pt-br" {
+		t.Errorf("expected pt-br, got %s", language)
+	}
+	if language := profiles.ResolveLanguage("otherchannel", ""); language != "en" {
+		t.Errorf("expected the buttifier's language, got %s", language)
+	}
+	// "trabalho" is split "tra-ba-lho" with the portuguese patterns and "tra-bal-ho" with english
+	b.ButtificationRate = 1
+	for channel, expected := range map[string]string{"brasil": "ba", "otherchannel": "bal"} {
+		result := profiles.Buttify(channel, "", "", "trabalho")
+		if len(result.Edits) != 3 || result.Edits[1].Original != expected {
+			t.Errorf("%s: expected the second syllable to be %s, got %+v", channel, expected, result.Edits)
+		}
+	}
+	if b.Language() != "en" {
+		t.Errorf("expected profiles not to change the buttifier's language, got %s", b.Language())
+	}
+}
+
+func TestProfilesButtWordOverridesButtWords(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	b.ButtWords = []WeightedWord{{Word: "booty", Weight: 1}}
+	profiles := NewProfiles(b)
+	profiles.SetChannel("somechannel", Profile{ButtWord: Override("bum")})
+	profiles.SetChannel("listchannel", Profile{
+		ButtWord:  Override("bum"),
+		ButtWords: []WeightedWord{{Word: "cheeks", Weight: 1}},
+	})
+	profiles.SetUser("someone", Profile{ButtWords: []WeightedWord{{Word: "rump", Weight: 1}}})
+
+	resultMap := map[[2]string]string{
+		{"otherchannel", ""}:       "bootybooty",
+		{"somechannel", ""}:        "bumbum",
+		{"listchannel", ""}:        "cheekscheeks",
+		{"somechannel", "someone"}: "rumprump",
+	}
+	for key, expected := range resultMap {
+		if actual := profiles.ButtifySentence(key[0], key[1], "grinding"); actual != expected {
+			t.Errorf("%v: expected %s, got %s", key, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package buttifier
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// how stressed a syllable is, lower values are replaced first by SelectStressed
+type Stress int
+
+const (
+	StressPrimary Stress = iota
+	StressSecondary
+	StressNone
+)
+
+var (
+	pronunciationsMu   sync.RWMutex
+	pronunciationsOnce sync.Once
+	// stress rank of every vowel of each word, keyed by language code and lowercase word
+	pronunciations = map[string]map[string][]Stress{}
+)
+
+// dictionaries shipped with the package, parsed the first time they're needed
+func loadBundledPronunciations() {
+	pronunciationsOnce.Do(func() {
+		stresses, err := parsePronunciations(strings.NewReader(PronunciationDataEN))
+		if err != nil {
+			panic(err)
+		}
+		pronunciationsMu.Lock()
+		defer pronunciationsMu.Unlock()
+		// words registered before the bundled ones were loaded take precedence
+		for word, stress := range pronunciations["en"] {
+			stresses[word] = stress
+		}
+		pronunciations["en"] = stresses
+	})
+}
+
+// parses a CMU Pronouncing Dictionary style file from r and adds its words to the
+// dictionary used by SelectStressed for code, replacing words already in it
+func RegisterPronunciations(code string, r io.Reader) error {
+	stresses, err := parsePronunciations(r)
+	if err != nil {
+		return fmt.Errorf("buttifier: parsing pronunciations for %q: %w", code, err)
+	}
+
+	code = normalizeLanguageCode(code)
+	pronunciationsMu.Lock()
+	defer pronunciationsMu.Unlock()
+	if pronunciations[code] == nil {
+		pronunciations[code] = map[string][]Stress{}
+	}
+	for word, stress := range stresses {
+		pronunciations[code][word] = stress
+	}
+	return nil
+}
+
+// "DEVELOPER  D IH0 V EH1 L AH0 P ER0" -> "developer": [none, primary, none, none].
+// lines starting with ";;;" are comments, and alternative pronunciations like "WORD(2)" are ignored
+func parsePronunciations(r io.Reader) (map[string][]Stress, error) {
+	stresses := map[string][]Stress{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], ";;;") || strings.HasSuffix(fields[0], ")") {
+			continue
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: %q has no phonemes", line, fields[0])
+		}
+
+		var stress []Stress
+		for _, phoneme := range fields[1:] {
+			// only vowels have a stress digit
+			switch phoneme[len(phoneme)-1] {
+			case '1':
+				stress = append(stress, StressPrimary)
+			case '2':
+				stress = append(stress, StressSecondary)
+			case '0':
+				stress = append(stress, StressNone)
+			}
+		}
+		if len(stress) > 0 {
+			stresses[strings.ToLower(fields[0])] = stress
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return stresses, nil
+}
+
+// the stress rank of each of word's syllables, nil when word isn't in the dictionary.
+// the dictionary's vowels are matched with the groups of vowel letters in the syllables,
+// and when there are more or fewer groups than vowels, each vowel goes to the group at
+// the same relative position
+func syllableStress(word *HyphenatedWord) []Stress {
+	loadBundledPronunciations()
+	pronunciationsMu.RLock()
+	dictionary, ok := pronunciations[word.Language]
+	if !ok {
+		base, _, _ := strings.Cut(word.Language, "-")
+		dictionary = pronunciations[base]
+	}
+	vowels := dictionary[strings.ToLower(word.Word)]
+	pronunciationsMu.RUnlock()
+	if vowels == nil {
+		return nil
+	}
+
+	// the syllable each group of vowel letters is in, "po-ta-to" -> [0, 0, 1] for "pota-to"
+	var groups []int
+	for i, syllable := range word.Syllables {
+		inGroup := false
+		for _, r := range strings.ToLower(syllable.Letters) {
+			vowel := strings.ContainsRune(vowelLetters, r)
+			if vowel && !inGroup {
+				groups = append(groups, i)
+			}
+			inGroup = vowel
+		}
+	}
+	if len(groups) == 0 {
+		return nil
+	}
+
+	stress := make([]Stress, len(word.Syllables))
+	for i := range stress {
+		stress[i] = StressNone
+	}
+	for i, rank := range vowels {
+		j := groups[(2*i+1)*len(groups)/(2*len(vowels))]
+		stress[j] = min(stress[j], rank)
+	}
+	return stress
+}
+
+const vowelLetters = "aeiouyàáâãäåèéêëìíîïòóôõöùúûüýÿæœ"
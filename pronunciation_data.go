@@ -0,0 +1,143 @@
+package buttifier
+
+// a small subset of the CMU Pronouncing Dictionary format: a word followed by its
+// ARPAbet phonemes, where the digit after each vowel is its stress:
+// 1 for primary, 2 for secondary and 0 for none
+const PronunciationDataEN = `;;; common English and chat words
+ABOUT  AH0 B AW1 T
+ACTUALLY  AE1 K CH AH0 W AH0 L IY0
+AGAIN  AH0 G EH1 N
+ALGORITHM  AE1 L G ER0 IH2 DH AH0 M
+ALWAYS  AO1 L W EY2 Z
+AMAZING  AH0 M EY1 Z IH0 NG
+ANIMAL  AE1 N AH0 M AH0 L
+ANOTHER  AH0 N AH1 DH ER0
+ANSWER  AE1 N S ER0
+ANYTHING  EH1 N IY0 TH IH2 NG
+AWESOME  AO1 S AH0 M
+BANANA  B AH0 N AE1 N AH0
+BANANAS  B AH0 N AE1 N AH0 Z
+BASKETBALL  B AE1 S K AH0 T B AO2 L
+BEAUTIFUL  B Y UW1 T AH0 F AH0 L
+BEFORE  B IH0 F AO1 R
+BEGIN  B IH0 G IH1 N
+BETTER  B EH1 T ER0
+BUTTER  B AH1 T ER0
+BUTTON  B AH1 T AH0 N
+CELEBRATE  S EH1 L AH0 B R EY2 T
+CHAMPION  CH AE1 M P IY0 AH0 N
+CHICKEN  CH IH1 K AH0 N
+CHOCOLATE  CH AO1 K L AH0 T
+COFFEE  K AA1 F IY0
+COMPUTER  K AH0 M P Y UW1 T ER0
+COMPUTERS  K AH0 M P Y UW1 T ER0 Z
+CONTENT  K AA1 N T EH0 N T
+CONTROLLER  K AH0 N T R OW1 L ER0
+DATABASE  D EY1 T AH0 B EY2 S
+DEFINITELY  D EH1 F AH0 N AH0 T L IY0
+DEVELOP  D IH0 V EH1 L AH0 P
+DEVELOPER  D IH0 V EH1 L AH0 P ER0
+DEVELOPERS  D IH0 V EH1 L AH0 P ER0 Z
+DIFFERENT  D IH1 F ER0 AH0 N T
+DINNER  D IH1 N ER0
+DISGUSTING  D IH0 S G AH1 S T IH0 NG
+DOCTOR  D AA1 K T ER0
+ELEPHANT  EH1 L AH0 F AH0 N T
+EMBARRASSING  IH0 M B EH1 R AH0 S IH0 NG
+EMOTE  IH0 M OW1 T
+EVERYBODY  EH1 V R IY0 B AA2 D IY0
+EVERYTHING  EH1 V R IY0 TH IH2 NG
+EXAMPLE  IH0 G Z AE1 M P AH0 L
+EXCELLENT  EH1 K S AH0 L AH0 N T
+FAMILY  F AE1 M AH0 L IY0
+FOREVER  F ER0 EH1 V ER0
+FRIDAY  F R AY1 D IY2
+FUNCTION  F AH1 NG K SH AH0 N
+FUNNY  F AH1 N IY0
+GAMING  G EY1 M IH0 NG
+GARDEN  G AA1 R D AH0 N
+GRINDING  G R AY1 N D IH0 NG
+GUITAR  G IH0 T AA1 R
+HAMBURGER  HH AE1 M B ER0 G ER0
+HAPPY  HH AE1 P IY0
+HELLO  HH AH0 L OW1
+HILARIOUS  HH IH0 L EH1 R IY0 AH0 S
+HOSPITAL  HH AA1 S P IH2 T AH0 L
+HOTEL  HH OW0 T EH1 L
+IMPORTANT  IH2 M P AO1 R T AH0 N T
+INCREDIBLE  IH2 N K R EH1 D AH0 B AH0 L
+INTERNET  IH1 N T ER0 N EH2 T
+KEYBOARD  K IY1 B AO2 R D
+LANGUAGE  L AE1 NG G W AH0 JH
+LAUGHING  L AE1 F IH0 NG
+LITERALLY  L IH1 T ER0 AH0 L IY0
+LITTLE  L IH1 T AH0 L
+MACHINE  M AH0 SH IY1 N
+MODERATOR  M AA1 D ER0 EY2 T ER0
+MONDAY  M AH1 N D IY0
+MONKEY  M AH1 NG K IY0
+MOTHER  M AH1 DH ER0
+MOUNTAIN  M AW1 N T AH0 N
+MUSIC  M Y UW1 Z IH0 K
+NOBODY  N OW1 B AA2 D IY0
+NOTHING  N AH1 TH IH0 NG
+NUMBER  N AH1 M B ER0
+OKAY  OW2 K EY1
+ORANGE  AO1 R AH0 N JH
+PARTNER  P AA1 R T N ER0
+PARTNERS  P AA1 R T N ER0 Z
+PENCIL  P EH1 N S AH0 L
+PEOPLE  P IY1 P AH0 L
+PERSON  P ER1 S AH0 N
+PICTURE  P IH1 K CH ER0
+PIZZA  P IY1 T S AH0
+PLAYER  P L EY1 ER0
+PLAYERS  P L EY1 ER0 Z
+PLAYING  P L EY1 IH0 NG
+POTATO  P AH0 T EY1 T OW2
+POTATOES  P AH0 T EY1 T OW2 Z
+PRETTY  P R IH1 T IY0
+PROBABLY  P R AA1 B AH0 B L IY0
+PROBLEM  P R AA1 B L AH0 M
+PROGRAM  P R OW1 G R AE2 M
+QUESTION  K W EH1 S CH AH0 N
+RABBIT  R AE1 B AH0 T
+REALLY  R IH1 L IY0
+REMEMBER  R IH0 M EH1 M B ER0
+RIDICULOUS  R IH0 D IH1 K Y AH0 L AH0 S
+RUNNING  R AH1 N IH0 NG
+SANDWICH  S AE1 N D W IH0 CH
+SATURDAY  S AE1 T ER0 D IY2
+SEVEN  S EH1 V AH0 N
+SISTER  S IH1 S T ER0
+SOFTWARE  S AO1 F T W EH2 R
+SOMEBODY  S AH1 M B AA2 D IY0
+SOMEONE  S AH1 M W AH2 N
+SOMETHING  S AH1 M TH IH0 NG
+SORRY  S AA1 R IY0
+SPAGHETTI  S P AH0 G EH1 T IY0
+SPECIAL  S P EH1 SH AH0 L
+STREAMER  S T R IY1 M ER0
+STREAMING  S T R IY1 M IH0 NG
+SUBSCRIBER  S AH0 B S K R AY1 B ER0
+SUCCESSFUL  S AH0 K S EH1 S F AH0 L
+SYLLABLE  S IH1 L AH0 B AH0 L
+TELEPHONE  T EH1 L AH0 F OW2 N
+TODAY  T AH0 D EY1
+TOGETHER  T AH0 G EH1 DH ER0
+TOMATO  T AH0 M EY1 T OW2
+TOMORROW  T AH0 M AA1 R OW2
+UMBRELLA  AH0 M B R EH1 L AH0
+UNDERSTAND  AH2 N D ER0 S T AE1 N D
+VACATION  V EY0 K EY1 SH AH0 N
+VARIABLE  V EH1 R IY0 AH0 B AH0 L
+VIDEO  V IH1 D IY0 OW2
+WATCHING  W AA1 CH IH0 NG
+WATER  W AO1 T ER0
+WEEKEND  W IY1 K EH2 N D
+WELCOME  W EH1 L K AH0 M
+WINDOW  W IH1 N D OW0
+WONDERFUL  W AH1 N D ER0 F AH0 L
+YESTERDAY  Y EH1 S T ER0 D EY2
+ZEBRA  Z IY1 B R AH0
+`
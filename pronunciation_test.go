@@ -0,0 +1,118 @@
+package buttifier
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// returns values in order, starting over after the last one
+type sequenceRandSource struct {
+	values []uint64
+	next   int
+}
+
+func (s *sequenceRandSource) Uint64() uint64 {
+	value := s.values[s.next%len(s.values)]
+	s.next++
+	return value
+}
+
+func TestPreferStressedWord(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 0.5
+
+	// only the first of the 4 rolls succeeds, so a single syllable is replaced
+	rolls := []uint64{0, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	b.RandSource = &sequenceRandSource{values: rolls}
+	if actual, _ := b.ButtifyWord("developer"); actual != "buttveloper" {
+		t.Errorf("expected buttveloper, got %s", actual)
+	}
+
+	b.PreferStressed = true
+	b.RandSource = &sequenceRandSource{values: rolls}
+	if actual, _ := b.ButtifyWord("developer"); actual != "debuttoper" {
+		t.Errorf("expected debuttoper, got %s", actual)
+	}
+	// "frizze-wade" isn't in the dictionary, so the syllable is picked uniformly with
+	// the next value, MaxUint64, which picks the last one
+	b.RandSource = &sequenceRandSource{values: rolls}
+	if actual, _ := b.ButtifyWord("frizzewade"); actual != "frizzebutt" {
+		t.Errorf("expected unknown words to be buttified as usual, got %s", actual)
+	}
+}
+
+func TestPreferStressedSentence(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.PreferStressed = true
+	b.ButtificationRate = 0.5
+	b.Rounding = RoundFloor
+
+	resultMap := map[string]string{
+		"hello computer": "helbutt combutter",
+		"potato":         "buttto",
+		"tomorrow":       "tobuttrow",
+	}
+	for text, expected := range resultMap {
+		actual := b.ButtifySentence(text)
+		if expected != actual {
+			t.Errorf("expected %s => %s, got %s => %s", text, expected, text, actual)
+		}
+	}
+}
+
+func TestSyllableStress(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.PreferStressed = true
+
+	resultMap := map[string][]Stress{
+		"developer": {StressNone, StressPrimary, StressNone, StressNone},
+		"potato":    {StressPrimary, StressSecondary},
+		"Something": {StressPrimary, StressNone},
+		"yesterday": {StressPrimary, StressNone, StressSecondary},
+		"frizze":    nil,
+	}
+	for word, expected := range resultMap {
+		actual := b.HyphenateWord(word).Stress
+		if !slices.Equal(actual, expected) {
+			t.Errorf("expected %s to have stress %v, got %v", word, expected, actual)
+		}
+	}
+}
+
+func TestRegisterPronunciations(t *testing.T) {
+	dictionary := ";;; comment\nPOGGERS  P AA1 G ER0 Z\nPOGGERS(2)  P AO0 G ER1 Z\n"
+	if err := RegisterPronunciations("en", strings.NewReader(dictionary)); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		pronunciationsMu.Lock()
+		delete(pronunciations["en"], "poggers")
+		pronunciationsMu.Unlock()
+	}()
+
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.PreferStressed = true
+	word := b.HyphenateWord("poggers")
+	if len(word.Stress) == 0 || word.Stress[0] != StressPrimary {
+		t.Errorf("expected the first syllable of %v to be stressed, got %v", word.Syllables, word.Stress)
+	}
+
+	if err := RegisterPronunciations("en", strings.NewReader("POGGERS\n")); err == nil {
+		t.Error("expected an error for a word without phonemes")
+	}
+}
@@ -0,0 +1,107 @@
+package buttifier
+
+import "strings"
+
+// a single replaced syllable
+type Edit struct {
+	// byte offsets of Original in Result.Input
+	Start int
+	End   int
+	// byte offsets of Replacement in Result.Output
+	OutputStart int
+	OutputEnd   int
+
+	Original    string
+	Replacement string
+
+	// position of the word among the words of the text, and of the syllable in that word
+	WordIndex     int
+	SyllableIndex int
+}
+
+type Result struct {
+	// the text that was buttified, with invalid UTF-8 replaced by U+FFFD
+	Input  string
+	Output string
+	// replaced syllables, in the order they appear in the text
+	Edits []Edit
+	// true if at least one syllable was replaced
+	Changed bool
+	// why MaybeButtify left the text alone, always NotSkipped for Buttify
+	Skipped SkipReason
+}
+
+// returns Output with only the given edits reverted, or Input when no edits are given
+func (r Result) Undo(edits ...Edit) string {
+	if len(edits) == 0 {
+		return r.Input
+	}
+
+	var builder strings.Builder
+	last := 0
+	for _, edit := range r.Edits {
+		revert := false
+		for _, e := range edits {
+			if e == edit {
+				revert = true
+				break
+			}
+		}
+		if !revert {
+			continue
+		}
+		builder.WriteString(r.Output[last:edit.OutputStart])
+		builder.WriteString(edit.Original)
+		last = edit.OutputEnd
+	}
+	builder.WriteString(r.Output[last:])
+	return builder.String()
+}
+
+// puts the words back between the untouched whitespace and punctuation,
+// replacing the syllables marked in replaced and recording an Edit for each
+func (s *session) buildResult(text string, tokens []Token, words []*HyphenatedWord, replaced [][]bool) Result {
+	result := Result{Input: text}
+
+	var output strings.Builder
+	wordIdx := 0
+	for _, token := range tokens {
+		if !s.isWordToken(token) {
+			output.WriteString(token.Text)
+			continue
+		}
+
+		offset := token.Start
+		for syllableIdx, syllable := range words[wordIdx].Syllables {
+			if replaced[wordIdx] == nil || !replaced[wordIdx][syllableIdx] {
+				output.WriteString(syllable.Letters)
+				offset += len(syllable.Letters)
+				continue
+			}
+
+			// normalize the replacement's case to match the syllable's case,
+			// emote codes are case-sensitive so ButtEmote is used as is
+			replacement := s.ButtEmote
+			if !words[wordIdx].emote {
+				replacement = normalizeCase(syllable.Letters, s.replacementWord(syllable.Letters))
+			}
+			result.Edits = append(result.Edits, Edit{
+				Start:         offset,
+				End:           offset + len(syllable.Letters),
+				OutputStart:   output.Len(),
+				OutputEnd:     output.Len() + len(replacement),
+				Original:      syllable.Letters,
+				Replacement:   replacement,
+				WordIndex:     wordIdx,
+				SyllableIndex: syllableIdx,
+			})
+			output.WriteString(replacement)
+			offset += len(syllable.Letters)
+		}
+		wordIdx++
+	}
+
+	result.Output = output.String()
+	result.Changed = len(result.Edits) > 0
+	return result
+}
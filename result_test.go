@@ -0,0 +1,71 @@
+package buttifier
+
+import (
+	"testing"
+)
+
+func TestButtify(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := b.Buttify("Grinding, for partner!")
+	if result.Output != "Buttbutt, for partner!" {
+		t.Errorf("expected Buttbutt, for partner!, got %s", result.Output)
+	}
+	if !result.Changed {
+		t.Error("expected Changed to be true")
+	}
+
+	expected := []Edit{
+		{Start: 0, End: 5, OutputStart: 0, OutputEnd: 4, Original: "Grind", Replacement: "Butt", WordIndex: 0, SyllableIndex: 0},
+		{Start: 5, End: 8, OutputStart: 4, OutputEnd: 8, Original: "ing", Replacement: "butt", WordIndex: 0, SyllableIndex: 1},
+	}
+	if len(result.Edits) != len(expected) {
+		t.Fatalf("expected %d edits, got %v", len(expected), result.Edits)
+	}
+	for i, edit := range result.Edits {
+		if edit != expected[i] {
+			t.Errorf("expected edit %+v, got %+v", expected[i], edit)
+		}
+		if result.Input[edit.Start:edit.End] != edit.Original {
+			t.Errorf("edit %+v doesn't point at the original text", edit)
+		}
+		if result.Output[edit.OutputStart:edit.OutputEnd] != edit.Replacement {
+			t.Errorf("edit %+v doesn't point at the replacement", edit)
+		}
+	}
+}
+
+func TestButtifyUnchanged(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, text := range []string{"", "?!", "  \t"} {
+		result := b.Buttify(text)
+		if result.Changed || len(result.Edits) > 0 || result.Output != text {
+			t.Errorf("expected %q to be unchanged, got %+v", text, result)
+		}
+	}
+}
+
+func TestResultUndo(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := b.Buttify("grinding for partner")
+	if actual := result.Undo(); actual != "grinding for partner" {
+		t.Errorf("expected the input back, got %s", actual)
+	}
+	if actual := result.Undo(result.Edits[1]); actual != "butting for partner" {
+		t.Errorf("expected butting for partner, got %s", actual)
+	}
+}
@@ -0,0 +1,33 @@
+package buttifier
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+)
+
+// a PCG stream that only depends on seed and key
+func seededSource(seed uint64, key string) *rand.PCG {
+	hash := fnv.New64a()
+	hash.Write([]byte(key))
+	return rand.NewPCG(seed, hash.Sum64())
+}
+
+// messages without an ID are identified by their text
+func messageKey(messageID string, text string) string {
+	if messageID == "" {
+		return text
+	}
+	return messageID
+}
+
+// same as Buttify, but in seeded mode the random stream is derived from
+// messageID instead of text, so a moderator can reproduce the output from
+// the seed and the ID of the chat message
+func (b *Buttifier) ButtifyMessage(messageID string, text string) Result {
+	return b.buttify(nil, messageKey(messageID, text), text)
+}
+
+// MaybeButtify version of ButtifyMessage
+func (b *Buttifier) MaybeButtifyMessage(messageID string, text string) Result {
+	return b.maybeButtify(nil, messageKey(messageID, text), text)
+}
@@ -0,0 +1,64 @@
+package buttifier
+
+import (
+	"testing"
+)
+
+func newSeededButtifier(t *testing.T, seed uint64) *Buttifier {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Seeded = true
+	b.Seed = seed
+	b.ButtificationProbability = 0.5
+	return b
+}
+
+func TestSeededButtifyIsReproducible(t *testing.T) {
+	messages := []string{
+		"grinding for partner",
+		"someone developers computer successful",
+		"the quick brown fox jumps over the lazy dog",
+	}
+
+	first := newSeededButtifier(t, 42)
+	second := newSeededButtifier(t, 42)
+	for _, message := range messages {
+		expected := first.Buttify(message).Output
+		for range 10 {
+			if actual := second.Buttify(message).Output; actual != expected {
+				t.Errorf("expected %s, got %s", expected, actual)
+			}
+			if actual := first.MaybeButtify(message); actual.Output != first.MaybeButtify(message).Output {
+				t.Errorf("expected MaybeButtify(%s) to be reproducible", message)
+			}
+		}
+	}
+}
+
+func TestSeededButtifyDependsOnSeedAndMessageID(t *testing.T) {
+	b := newSeededButtifier(t, 1)
+	other := newSeededButtifier(t, 2)
+
+	message := "the quick brown fox jumps over the lazy dog while someone developers computer"
+	differentSeeds, differentIDs := false, false
+	for _, id := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		output := b.ButtifyMessage(id, message).Output
+		if output != b.ButtifyMessage(id, message).Output {
+			t.Errorf("expected ButtifyMessage(%s) to be reproducible", id)
+		}
+		if output != other.ButtifyMessage(id, message).Output {
+			differentSeeds = true
+		}
+		if output != b.ButtifyMessage(id+"x", message).Output {
+			differentIDs = true
+		}
+	}
+	if !differentSeeds {
+		t.Error("expected different seeds to give different outputs")
+	}
+	if !differentIDs {
+		t.Error("expected different message IDs to give different outputs")
+	}
+}
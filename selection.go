@@ -0,0 +1,93 @@
+package buttifier
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+type Rounding int
+
+const (
+	// round the target number of replaced syllables down
+	RoundFloor Rounding = iota
+	// round the target number of replaced syllables up
+	RoundCeil
+	// round up with a probability equal to the fractional part, so the
+	// rate is hit exactly on average
+	RoundProbabilistic
+)
+
+// points at syllable Syllable of word Word in a hyphenated sentence
+type syllableRef struct {
+	Word     int
+	Syllable int
+}
+
+// how many of total syllables should be replaced to hit ButtificationRate,
+// capped by total and MaxReplacements
+func (s *session) targetReplacements(total int) int {
+	target := s.ButtificationRate * float64(total)
+
+	var count int
+	switch s.Rounding {
+	case RoundFloor:
+		count = int(math.Floor(target))
+	case RoundProbabilistic:
+		count = int(math.Floor(target))
+		if s.rng.Float64() < target-math.Floor(target) {
+			count++
+		}
+	default:
+		count = int(math.Ceil(target))
+	}
+
+	count = min(max(count, 0), total)
+	if s.MaxReplacements > 0 {
+		count = min(count, s.MaxReplacements)
+	}
+	return count
+}
+
+// picks count distinct syllables with a partial Fisher-Yates shuffle,
+// returned in the order they appear in the sentence
+func pickUniformly(syllables []syllableRef, count int, rng *rand.Rand) []syllableRef {
+	shuffled := append([]syllableRef(nil), syllables...)
+	for i := 0; i < count; i++ {
+		j := i + rng.Int()%(len(shuffled)-i)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+	picked := shuffled[:count]
+	sortByPosition(picked)
+	return picked
+}
+
+// like pickUniformly, but every syllable with a lower rank is picked before
+// any syllable with a higher one
+func pickByRank(syllables []syllableRef, count int, rank func(syllableRef) int, rng *rand.Rand) []syllableRef {
+	sorted := slices.Clone(syllables)
+	slices.SortStableFunc(sorted, func(a, b syllableRef) int {
+		return rank(a) - rank(b)
+	})
+
+	var picked []syllableRef
+	for start := 0; len(picked) < count && start < len(sorted); {
+		end := start
+		for end < len(sorted) && rank(sorted[end]) == rank(sorted[start]) {
+			end++
+		}
+		picked = append(picked, pickUniformly(sorted[start:end], min(count-len(picked), end-start), rng)...)
+		start = end
+	}
+	sortByPosition(picked)
+	return picked
+}
+
+func sortByPosition(syllables []syllableRef) {
+	slices.SortFunc(syllables, func(a, b syllableRef) int {
+		if a.Word != b.Word {
+			return a.Word - b.Word
+		}
+		return a.Syllable - b.Syllable
+	})
+}
@@ -0,0 +1,77 @@
+package buttifier
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestTargetReplacements(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 0.3
+
+	type testCase struct {
+		rounding        Rounding
+		maxReplacements int
+		total           int
+		expected        int
+	}
+	testCases := []testCase{
+		{RoundFloor, 0, 5, 1},
+		{RoundCeil, 0, 5, 2},
+		// the constant source always rounds up
+		{RoundProbabilistic, 0, 5, 2},
+		{RoundProbabilistic, 0, 10, 3},
+		{RoundCeil, 0, 0, 0},
+		{RoundCeil, 1, 5, 1},
+		{RoundCeil, 10, 100, 10},
+	}
+	for _, tc := range testCases {
+		b.Rounding = tc.rounding
+		b.MaxReplacements = tc.maxReplacements
+		actual := b.newSession("").targetReplacements(tc.total)
+		if actual != tc.expected {
+			t.Errorf("%+v: expected %d, got %d", tc, tc.expected, actual)
+		}
+	}
+
+	b.ButtificationRate = 2
+	b.MaxReplacements = 0
+	if actual := b.newSession("").targetReplacements(5); actual != 5 {
+		t.Errorf("expected the target to be capped at the number of syllables, got %d", actual)
+	}
+}
+
+func TestButtifyHitsRate(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.RandSource = rand.NewPCG(1, 2)
+	b.ButtificationRate = 0.5
+	b.Rounding = RoundFloor
+
+	sentence := "someone developers computer butt"
+	for range 100 {
+		result := b.Buttify(sentence)
+		if len(result.Edits) != 5 {
+			t.Fatalf("expected exactly 5 replaced syllables, got %d: %s", len(result.Edits), result.Output)
+		}
+		seen := map[syllableRef]bool{}
+		for _, edit := range result.Edits {
+			ref := syllableRef{edit.WordIndex, edit.SyllableIndex}
+			if seen[ref] {
+				t.Fatalf("syllable %+v replaced twice", ref)
+			}
+			seen[ref] = true
+		}
+	}
+
+	b.MaxReplacements = 2
+	if result := b.Buttify(sentence); len(result.Edits) != 2 {
+		t.Errorf("expected MaxReplacements to cap replacements at 2, got %d", len(result.Edits))
+	}
+}
@@ -0,0 +1,167 @@
+package buttifier
+
+import (
+	"math/rand/v2"
+)
+
+// decides which syllables of a hyphenated sentence get replaced, set it with Config.Selection.
+// ButtifyWord passes a single word
+type SelectionStrategy interface {
+	// returns which syllables of each word to replace, indexed like words[i].Syllables.
+	// a nil slice replaces nothing in that word.
+	// target is how many syllables ButtificationRate asks for, strategies may pick fewer.
+	// syllables that aren't Replaceable and picks past MaxReplacements are ignored
+	Select(words []*HyphenatedWord, target int, rng *rand.Rand) [][]bool
+}
+
+// every replaceable syllable is equally likely, the default
+type SelectUniform struct{}
+
+func (SelectUniform) Select(words []*HyphenatedWord, target int, rng *rand.Rand) [][]bool {
+	return markRefs(words, pickUniformly(eligibleRefs(words), target, rng))
+}
+
+// syllables with primary stress are picked first, then the ones with secondary stress.
+// all syllables of words missing from the pronunciation dictionary count as stressed
+type SelectStressed struct{}
+
+func (SelectStressed) Select(words []*HyphenatedWord, target int, rng *rand.Rand) [][]bool {
+	return markRefs(words, pickByRank(eligibleRefs(words), target, func(ref syllableRef) int {
+		stress := words[ref.Word].Stress
+		if stress == nil {
+			return int(StressPrimary)
+		}
+		return int(stress[ref.Syllable])
+	}, rng))
+}
+
+// only the first replaceable syllable of random words
+type SelectFirst struct{}
+
+func (SelectFirst) Select(words []*HyphenatedWord, target int, rng *rand.Rand) [][]bool {
+	return selectOnePerWord(words, target, rng, func(word *HyphenatedWord) int {
+		for i := range word.Syllables {
+			if word.Replaceable(i) {
+				return i
+			}
+		}
+		return -1
+	})
+}
+
+// only the last replaceable syllable of random words
+type SelectLast struct{}
+
+func (SelectLast) Select(words []*HyphenatedWord, target int, rng *rand.Rand) [][]bool {
+	return selectOnePerWord(words, target, rng, func(word *HyphenatedWord) int {
+		for i := len(word.Syllables) - 1; i >= 0; i-- {
+			if word.Replaceable(i) {
+				return i
+			}
+		}
+		return -1
+	})
+}
+
+// only the longest replaceable syllable of random words, the first one on ties
+type SelectLongest struct{}
+
+func (SelectLongest) Select(words []*HyphenatedWord, target int, rng *rand.Rand) [][]bool {
+	return selectOnePerWord(words, target, rng, func(word *HyphenatedWord) int {
+		longest := -1
+		for i, syllable := range word.Syllables {
+			if word.Replaceable(i) && (longest == -1 ||
+				syllable.IdxEnd-syllable.IdxStart > word.Syllables[longest].IdxEnd-word.Syllables[longest].IdxStart) {
+				longest = i
+			}
+		}
+		return longest
+	})
+}
+
+// like SelectUniform, but never more than N syllables of the same word.
+// N below 1 is treated as 1
+type SelectAtMostPerWord struct {
+	N int
+}
+
+func (a SelectAtMostPerWord) Select(words []*HyphenatedWord, target int, rng *rand.Rand) [][]bool {
+	limit := max(a.N, 1)
+	shuffled := eligibleRefs(words)
+	perWord := make([]int, len(words))
+
+	var picked []syllableRef
+	for i := 0; i < len(shuffled) && len(picked) < target; i++ {
+		j := i + rng.Int()%(len(shuffled)-i)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		if ref := shuffled[i]; perWord[ref.Word] < limit {
+			perWord[ref.Word]++
+			picked = append(picked, ref)
+		}
+	}
+	return markRefs(words, picked)
+}
+
+// picks up to target random words and the syllable choose returns for each of them.
+// choose returns -1 for words with nothing to replace
+func selectOnePerWord(words []*HyphenatedWord, target int, rng *rand.Rand, choose func(*HyphenatedWord) int) [][]bool {
+	var candidates []syllableRef
+	for i, word := range words {
+		if j := choose(word); j >= 0 {
+			candidates = append(candidates, syllableRef{i, j})
+		}
+	}
+	return markRefs(words, pickUniformly(candidates, min(target, len(candidates)), rng))
+}
+
+// every replaceable syllable of words, in order
+func eligibleRefs(words []*HyphenatedWord) []syllableRef {
+	var refs []syllableRef
+	for i, word := range words {
+		for j := range word.Syllables {
+			if word.Replaceable(j) {
+				refs = append(refs, syllableRef{i, j})
+			}
+		}
+	}
+	return refs
+}
+
+func markRefs(words []*HyphenatedWord, refs []syllableRef) [][]bool {
+	replaced := make([][]bool, len(words))
+	for _, ref := range refs {
+		if replaced[ref.Word] == nil {
+			replaced[ref.Word] = make([]bool, len(words[ref.Word].Syllables))
+		}
+		replaced[ref.Word][ref.Syllable] = true
+	}
+	return replaced
+}
+
+// runs the configured strategy and drops the picks it isn't allowed to make
+func (s *session) selectSyllables(words []*HyphenatedWord, target int) [][]bool {
+	strategy := s.Selection
+	if strategy == nil {
+		strategy = SelectUniform{}
+		if s.PreferStressed {
+			strategy = SelectStressed{}
+		}
+	}
+	picked := strategy.Select(words, target, s.rng)
+
+	replaced := make([][]bool, len(words))
+	count := 0
+	for i := 0; i < min(len(words), len(picked)); i++ {
+		for j := 0; j < min(len(words[i].Syllables), len(picked[i])); j++ {
+			if !picked[i][j] || !words[i].Replaceable(j) || (s.MaxReplacements > 0 && count >= s.MaxReplacements) {
+				continue
+			}
+			if replaced[i] == nil {
+				replaced[i] = make([]bool, len(words[i].Syllables))
+			}
+			replaced[i][j] = true
+			count++
+		}
+	}
+	return replaced
+}
@@ -0,0 +1,88 @@
+package buttifier
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+func TestSelectionStrategies(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+
+	resultMap := map[SelectionStrategy]string{
+		SelectUniform{}:           "buttbuttbuttbutt buttbutt",
+		SelectFirst{}:             "buttveloper buttner",
+		SelectLast{}:              "developbutt partbutt",
+		SelectLongest{}:           "debuttoper buttner",
+		SelectStressed{}:          "buttbuttbuttbutt buttbutt",
+		SelectAtMostPerWord{N: 2}: "buttbuttoper buttbutt",
+		SelectAtMostPerWord{N: 0}: "buttveloper buttner",
+	}
+	for strategy, expected := range resultMap {
+		b.Selection = strategy
+		actual := b.ButtifySentence("developer partner")
+		if expected != actual {
+			t.Errorf("%T%v: expected %s, got %s", strategy, strategy, expected, actual)
+		}
+	}
+}
+
+func TestSelectionStrategyTarget(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 0.25
+	b.Rounding = RoundFloor
+
+	resultMap := map[SelectionStrategy]string{
+		SelectStressed{}: "debuttoper partner",
+		SelectLast{}:     "developbutt partner",
+	}
+	for strategy, expected := range resultMap {
+		b.Selection = strategy
+		actual := b.ButtifySentence("developer partner")
+		if expected != actual {
+			t.Errorf("%T: expected %s, got %s", strategy, expected, actual)
+		}
+	}
+}
+
+// picks every syllable of every word, including the ones it shouldn't
+type greedyStrategy struct{}
+
+func (greedyStrategy) Select(words []*HyphenatedWord, target int, rng *rand.Rand) [][]bool {
+	replaced := make([][]bool, len(words))
+	for i, word := range words {
+		replaced[i] = make([]bool, len(word.Syllables)+1)
+		for j := range replaced[i] {
+			replaced[i][j] = true
+		}
+	}
+	return replaced
+}
+
+func TestCustomSelectionStrategy(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Selection = greedyStrategy{}
+	b.KeepSuffixes = true
+	b.MaxReplacements = 3
+
+	result := b.Buttify("developers partner")
+	if result.Output != "buttbuttbutters partner" {
+		t.Errorf("expected buttbuttbutters partner, got %s", result.Output)
+	}
+
+	if actual, _ := b.ButtifyWord("partners"); actual != "buttbutts" {
+		t.Errorf("expected buttbutts, got %s", actual)
+	}
+}
@@ -0,0 +1,207 @@
+package buttifier
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/speedata/hyphenation"
+)
+
+// splits words into syllables, see WithSyllabifier
+type Syllabifier interface {
+	// returns the rune offsets where each syllable of word ends, in increasing order.
+	// the end of the word may be left out
+	Syllabify(word string) []int
+}
+
+// hyphenates with TeX patterns, the default for every language. TeX patterns mark
+// where a word may be hyphenated when typesetting, which is close to, but not quite,
+// where its syllables are. see ParsePatterns for loading them from files
+type TeXSyllabifier struct {
+	Patterns *hyphenation.Lang
+	// breakpoints of lowercase words, like a \hyphenation{} block.
+	// these words are split this way instead of with the patterns
+	Exceptions map[string][]int
+}
+
+func (t TeXSyllabifier) Syllabify(word string) []int {
+	if breakpoints, ok := t.Exceptions[strings.ToLower(word)]; ok {
+		return slices.Clone(breakpoints)
+	}
+
+	length := len([]rune(word))
+	if t.Patterns == nil {
+		return []int{length}
+	}
+	breakpoints := t.Patterns.Hyphenate(word)
+
+	if len(breakpoints) == 0 {
+		// some words like "partne" return an empty slice, so we need to add a breakpoint
+		return []int{length}
+	} else if len(breakpoints) == 1 && breakpoints[0] == length-1 {
+		// words like "asd" return []int{2}, resulting in "as" instead of "asd"
+		return []int{length}
+	} else if breakpoints[len(breakpoints)-1] != length {
+		// words with a single breakpoint like "partner" return []int{4}, resulting in "part" instead of "partner"
+		breakpoints = append(breakpoints, length)
+	}
+	return breakpoints
+}
+
+// splits words into phonetic syllables without patterns, so it works for any language
+// written in the Latin alphabet: every group of vowels is a syllable, and the consonants
+// between two of them go to the second one when they can start a syllable
+// ("ba-na-na", "mo-ther", "part-ner", "a-stro-naut"), which is decided by their sonority
+type PhoneticSyllabifier struct {
+	// don't count a final "e" after a consonant as a syllable, like in "make" or "partne",
+	// unless it's a "le" like in "table". meant for English
+	SilentFinalE bool
+}
+
+// a letter, or letters that sound like a single consonant like "ch" or "qu"
+type phoneticUnit struct {
+	start, end int
+	vowel      bool
+	letter     bool
+	// 1 for stops, 2 for fricatives, 3 for nasals, 4 for liquids and 5 for glides
+	sonority int
+	text     string
+}
+
+const (
+	sonorityStop      = 1
+	sonorityFricative = 2
+	sonorityNasal     = 3
+	sonorityLiquid    = 4
+	sonorityGlide     = 5
+)
+
+// consonants that are heard as one
+var consonantDigraphs = map[string]bool{"ch": true, "sh": true, "th": true, "ph": true, "wh": true, "gh": true}
+
+func (p PhoneticSyllabifier) Syllabify(word string) []int {
+	runes := []rune(word)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	units := phoneticUnits(runes)
+
+	if p.SilentFinalE && len(units) >= 2 {
+		last, previous := units[len(units)-1], units[len(units)-2]
+		syllabicLE := previous.text == "l" && len(units) >= 3 && !units[len(units)-3].vowel
+		if last.text == "e" && !previous.vowel && previous.letter && !syllabicLE {
+			for _, unit := range units[:len(units)-1] {
+				if unit.vowel {
+					units[len(units)-1].vowel = false
+					break
+				}
+			}
+		}
+	}
+
+	// the first and last unit of each group of vowels
+	var nuclei [][2]int
+	for i, unit := range units {
+		if !unit.vowel {
+			continue
+		}
+		if len(nuclei) > 0 && nuclei[len(nuclei)-1][1] == i-1 {
+			nuclei[len(nuclei)-1][1] = i
+		} else {
+			nuclei = append(nuclei, [2]int{i, i})
+		}
+	}
+
+	var breakpoints []int
+	for k := 1; k < len(nuclei); k++ {
+		clusterStart, next := nuclei[k-1][1]+1, nuclei[k][0]
+		onset := next
+		for i := next - 1; i >= clusterStart && units[i].letter && validOnset(units[i:next]); i-- {
+			onset = i
+		}
+		for i := clusterStart; i < next; i++ {
+			if !units[i].letter {
+				// "well-known" breaks right after the hyphen
+				onset = i + 1
+			}
+		}
+		breakpoints = append(breakpoints, units[onset].start)
+	}
+	return append(breakpoints, len(runes))
+}
+
+func phoneticUnits(runes []rune) []phoneticUnit {
+	var units []phoneticUnit
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		var next rune
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+
+		switch {
+		case !unicode.IsLetter(r):
+			units = append(units, phoneticUnit{start: i, end: i + 1, text: string(r)})
+		case r == 'q' && next == 'u':
+			units = append(units, phoneticUnit{start: i, end: i + 2, letter: true, sonority: sonorityStop, text: "qu"})
+		case consonantDigraphs[string([]rune{r, next})]:
+			units = append(units, phoneticUnit{start: i, end: i + 2, letter: true, sonority: sonorityFricative, text: string([]rune{r, next})})
+		case r == 'y':
+			// a consonant in "yes" or "player", a vowel in "happy" or "sky"
+			vowel := !isVowelLetter(next)
+			units = append(units, phoneticUnit{start: i, end: i + 1, letter: true, vowel: vowel, sonority: sonorityGlide, text: "y"})
+		case isVowelLetter(r):
+			units = append(units, phoneticUnit{start: i, end: i + 1, letter: true, vowel: true, text: string(r)})
+		default:
+			units = append(units, phoneticUnit{start: i, end: i + 1, letter: true, sonority: consonantSonority(r), text: string(r)})
+		}
+		i = units[len(units)-1].end
+	}
+	return units
+}
+
+func isVowelLetter(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u',
+		'à', 'á', 'â', 'ã', 'ä', 'å', 'æ', 'è', 'é', 'ê', 'ë', 'ì', 'í', 'î', 'ï',
+		'ò', 'ó', 'ô', 'õ', 'ö', 'ø', 'œ', 'ù', 'ú', 'û', 'ü', 'ý', 'ÿ':
+		return true
+	}
+	return false
+}
+
+func consonantSonority(r rune) int {
+	switch r {
+	case 'w', 'j':
+		return sonorityGlide
+	case 'l', 'r':
+		return sonorityLiquid
+	case 'm', 'n', 'ñ':
+		return sonorityNasal
+	case 'f', 'v', 's', 'z', 'h', 'x', 'ç', 'ß':
+		return sonorityFricative
+	default:
+		return sonorityStop
+	}
+}
+
+// whether units can start a syllable: any single consonant, a stop or fricative followed
+// by a liquid or glide like "pr" or "fl", "s" followed by a consonant like "st" or "sm",
+// or "s", a stop and a liquid or glide like "str"
+func validOnset(units []phoneticUnit) bool {
+	switch len(units) {
+	case 1:
+		return true
+	case 2:
+		first, second := units[0], units[1]
+		if first.text == "s" {
+			return second.text != "s"
+		}
+		return first.sonority <= sonorityFricative && second.sonority >= sonorityLiquid
+	case 3:
+		return units[0].text == "s" && units[1].sonority == sonorityStop && units[2].sonority >= sonorityLiquid
+	default:
+		return false
+	}
+}
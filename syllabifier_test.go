@@ -0,0 +1,95 @@
+package buttifier
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPhoneticSyllabifier(t *testing.T) {
+	b, err := New(WithSyllabifier(PhoneticSyllabifier{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resultMap := map[string]string{
+		"partne":      "part-ne",
+		"partner":     "part-ner",
+		"banana":      "ba-na-na",
+		"mother":      "mo-ther",
+		"astronaut":   "a-stro-naut",
+		"player":      "pla-yer",
+		"happy":       "hap-py",
+		"well-known":  "well--known",
+		"pogchamp":    "pog-champ",
+		"rápidamente": "rá-pi-da-men-te",
+		"hmm":         "hmm",
+	}
+	for word, expected := range resultMap {
+		actual := joinSyllables(b.HyphenateWord(word))
+		if actual != expected {
+			t.Errorf("expected %s => %s, got %s", word, expected, actual)
+		}
+	}
+}
+
+func TestPhoneticSyllabifierSilentFinalE(t *testing.T) {
+	b, err := New(WithSyllabifier(PhoneticSyllabifier{SilentFinalE: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resultMap := map[string]string{
+		"partne": "partne",
+		"make":   "make",
+		"table":  "ta-ble",
+		"me":     "me",
+	}
+	for word, expected := range resultMap {
+		actual := joinSyllables(b.HyphenateWord(word))
+		if actual != expected {
+			t.Errorf("expected %s => %s, got %s", word, expected, actual)
+		}
+	}
+}
+
+// returns breakpoints out of order, outside the word and without the end of it
+type sloppySyllabifier struct{}
+
+func (sloppySyllabifier) Syllabify(word string) []int {
+	return []int{0, 2, 1, 2, 100}
+}
+
+func TestCustomSyllabifier(t *testing.T) {
+	b, err := New(WithSyllabifier(sloppySyllabifier{}), WithLanguage("xx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Language() != "xx" {
+		t.Errorf("expected any language to be accepted without patterns, got %s", b.Language())
+	}
+
+	actual := joinSyllables(b.HyphenateWord("butt"))
+	if actual != "bu-tt" {
+		t.Errorf("expected bu-tt, got %s", actual)
+	}
+}
+
+func TestNewOptions(t *testing.T) {
+	b, err := New(WithLanguage("pt"), WithLanguages("es", "de"), WithRandSource(UnitTestRandSource{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Language() != "pt" {
+		t.Errorf("expected pt, got %s", b.Language())
+	}
+	if len(b.syllabifiers) != 3 {
+		t.Errorf("expected 3 languages to be loaded, got %d", len(b.syllabifiers))
+	}
+	if _, ok := b.RandSource.(UnitTestRandSource); !ok {
+		t.Errorf("expected UnitTestRandSource, got %T", b.RandSource)
+	}
+
+	if _, err := New(WithLanguages("xx")); !errors.Is(err, ErrUnknownLanguage) {
+		t.Errorf("expected ErrUnknownLanguage, got %v", err)
+	}
+}
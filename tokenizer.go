@@ -0,0 +1,299 @@
+package buttifier
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// kinds are bit flags, so a set of kinds can be combined with |, see Config.Unprotect
+type TokenKind int
+
+const (
+	// letters, digits and marks, including inner apostrophes and hyphens like "don't" or "well-known"
+	TokenWord TokenKind = 1 << iota
+	// a run of spaces, tabs or newlines
+	TokenSpace
+	// a run of punctuation like "," or "?!"
+	TokenPunctuation
+	// anything else, like emoji or math symbols
+	TokenSymbol
+	// "@username"
+	TokenMention
+	// "https://example.com/path" or "www.example.com"
+	TokenURL
+	// "someone@example.com"
+	TokenEmail
+	// "!uptime", only at the start of the text or after whitespace
+	TokenCommand
+	// "#hashtag"
+	TokenHashtag
+	// an emote code from Config.Emotes, like "KEKW"
+	TokenEmote
+)
+
+// kinds that are never hyphenated or replaced, unless they're in Config.Unprotect
+const ProtectedTokens = TokenMention | TokenURL | TokenEmail | TokenCommand | TokenHashtag | TokenEmote
+
+func (k TokenKind) String() string {
+	switch k {
+	case TokenWord:
+		return "word"
+	case TokenSpace:
+		return "space"
+	case TokenPunctuation:
+		return "punctuation"
+	case TokenMention:
+		return "mention"
+	case TokenURL:
+		return "url"
+	case TokenEmail:
+		return "email"
+	case TokenCommand:
+		return "command"
+	case TokenHashtag:
+		return "hashtag"
+	case TokenEmote:
+		return "emote"
+	default:
+		return "symbol"
+	}
+}
+
+type Token struct {
+	Kind TokenKind
+	Text string
+	// byte offsets of Text in the tokenized string
+	Start int
+	End   int
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r) || r == '_'
+}
+
+// apostrophes and hyphens only belong to a word when surrounded by word runes
+func isWordJoiner(r rune) bool {
+	return r == '\'' || r == '’' || r == '-'
+}
+
+func runeKind(r rune) TokenKind {
+	switch {
+	case isWordRune(r):
+		return TokenWord
+	case unicode.IsSpace(r):
+		return TokenSpace
+	case unicode.IsPunct(r):
+		return TokenPunctuation
+	default:
+		return TokenSymbol
+	}
+}
+
+var (
+	urlPattern     = regexp.MustCompile(`^(?i:https?://|www\.)[^\s]+`)
+	emailPattern   = regexp.MustCompile(`^[\pL\pN\pM_.%+-]+@[\pL\pN\pM-]+(?:\.[\pL\pN\pM-]+)+`)
+	mentionPattern = regexp.MustCompile(`^@[\pL\pN\pM_]+`)
+	commandPattern = regexp.MustCompile(`^![\pL\pN\pM_]+`)
+	hashtagPattern = regexp.MustCompile(`^#[\pL\pN\pM_]+`)
+)
+
+// punctuation that usually ends the sentence around a URL rather than the URL itself
+const urlTrailing = `.,;:!?'"’”»`
+
+// the length of the protected token at the start of text, if there's one.
+// previous is the rune right before text, or -1 at the start
+func protectedToken(text string, previous rune) (TokenKind, int) {
+	if isWordRune(previous) {
+		return 0, 0
+	}
+	if loc := urlPattern.FindStringIndex(text); loc != nil {
+		url := text[:loc[1]]
+		for {
+			trimmed := strings.TrimRight(url, urlTrailing)
+			// "(see https://example.com)" but not "https://en.wikipedia.org/wiki/Go_(game)"
+			if strings.HasSuffix(trimmed, ")") && strings.Count(trimmed, "(") < strings.Count(trimmed, ")") {
+				trimmed = trimmed[:len(trimmed)-1]
+			}
+			if trimmed == url {
+				break
+			}
+			url = trimmed
+		}
+		return TokenURL, len(url)
+	}
+	if loc := emailPattern.FindStringIndex(text); loc != nil {
+		return TokenEmail, loc[1]
+	}
+	if loc := mentionPattern.FindStringIndex(text); loc != nil {
+		return TokenMention, loc[1]
+	}
+	if loc := hashtagPattern.FindStringIndex(text); loc != nil {
+		return TokenHashtag, loc[1]
+	}
+	if previous == -1 || unicode.IsSpace(previous) {
+		if loc := commandPattern.FindStringIndex(text); loc != nil {
+			return TokenCommand, loc[1]
+		}
+	}
+	return 0, 0
+}
+
+// splits text into words, whitespace, punctuation and symbols, and the protected
+// mentions, URLs, emails, commands and hashtags.
+// concatenating the Text of every token gives back text unchanged
+func Tokenize(text string) []Token {
+	return tokenize(text, 0, true)
+}
+
+// offset is added to the offsets of every token.
+// protected tokens are only recognized when protect is true
+func tokenize(text string, offset int, protect bool) []Token {
+	var tokens []Token
+	start := 0
+	for start < len(text) {
+		if protect {
+			previous, _ := utf8.DecodeLastRuneInString(text[:start])
+			if start == 0 {
+				previous = -1
+			}
+			if kind, size := protectedToken(text[start:], previous); size > 0 {
+				end := start + size
+				tokens = append(tokens, Token{Kind: kind, Text: text[start:end], Start: offset + start, End: offset + end})
+				start = end
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(text[start:])
+		kind := runeKind(r)
+		end := start + size
+
+		for end < len(text) {
+			next, nextSize := utf8.DecodeRuneInString(text[end:])
+			if runeKind(next) == kind {
+				end += nextSize
+				continue
+			}
+			if kind == TokenWord && isWordJoiner(next) {
+				// "don't", but not "don'" or "'cause"
+				after, _ := utf8.DecodeRuneInString(text[end+nextSize:])
+				if end+nextSize < len(text) && isWordRune(after) {
+					end += nextSize
+					continue
+				}
+			}
+			break
+		}
+
+		tokens = append(tokens, Token{Kind: kind, Text: text[start:end], Start: offset + start, End: offset + end})
+		start = end
+	}
+	return tokens
+}
+
+// tokenizes text the way the buttifier sees it: codes from Config.Emotes become
+// TokenEmote, tokens of the kinds in Config.Unprotect are split back into words
+// and, with Config.SplitIdentifiers, words are split into their sub-words
+func (s *session) tokenize(text string) []Token {
+	var tokens []Token
+	last := 0
+	if s.Emotes != nil {
+		// emotes are whitespace separated and may contain punctuation, like "D:" or "<3"
+		start := 0
+		for start < len(text) {
+			r, size := utf8.DecodeRuneInString(text[start:])
+			if unicode.IsSpace(r) {
+				start += size
+				continue
+			}
+			end := strings.IndexFunc(text[start:], unicode.IsSpace)
+			if end == -1 {
+				end = len(text)
+			} else {
+				end += start
+			}
+			if s.Emotes.Contains(text[start:end]) {
+				tokens = append(tokens, tokenize(text[last:start], last, true)...)
+				tokens = append(tokens, Token{Kind: TokenEmote, Text: text[start:end], Start: start, End: end})
+				last = end
+			}
+			start = end
+		}
+	}
+	tokens = append(tokens, tokenize(text[last:], last, true)...)
+
+	if s.Unprotect&ProtectedTokens != 0 {
+		var result []Token
+		for _, token := range tokens {
+			if token.Kind&ProtectedTokens&s.Unprotect != 0 {
+				result = append(result, tokenize(token.Text, token.Start, false)...)
+			} else {
+				result = append(result, token)
+			}
+		}
+		tokens = result
+	}
+
+	if !s.SplitIdentifiers {
+		return tokens
+	}
+	var result []Token
+	for _, token := range tokens {
+		if token.Kind == TokenWord {
+			result = append(result, splitIdentifier(token)...)
+		} else {
+			result = append(result, token)
+		}
+	}
+	return result
+}
+
+// splits a word token like "getUserName", "snake_case", "well-known" or "level2boss"
+// into word tokens for each part, with the underscores and hyphens between them as
+// punctuation tokens. "HTTPServer" is split into "HTTP" and "Server"
+func splitIdentifier(token Token) []Token {
+	var tokens []Token
+	emit := func(kind TokenKind, start int, end int) {
+		if start < end {
+			tokens = append(tokens, Token{Kind: kind, Text: token.Text[start:end], Start: token.Start + start, End: token.Start + end})
+		}
+	}
+
+	text := token.Text
+	start := 0
+	// the last rune that isn't a mark, marks belong to the letter before them
+	previous := rune(-1)
+	for i, r := range text {
+		if unicode.IsMark(r) {
+			continue
+		}
+		isSeparator := r == '_' || r == '-'
+		wasSeparator := previous == '_' || previous == '-'
+		split := isSeparator != wasSeparator
+		if !isSeparator && !wasSeparator && previous != -1 {
+			next, _ := utf8.DecodeRuneInString(text[i+utf8.RuneLen(r):])
+			split = unicode.IsDigit(previous) != unicode.IsDigit(r) ||
+				// "userName"
+				unicode.IsLower(previous) && unicode.IsUpper(r) ||
+				// "HTTPServer"
+				unicode.IsUpper(previous) && unicode.IsUpper(r) && unicode.IsLower(next)
+		}
+		if split && previous != -1 {
+			if wasSeparator {
+				emit(TokenPunctuation, start, i)
+			} else {
+				emit(TokenWord, start, i)
+			}
+			start = i
+		}
+		previous = r
+	}
+	if previous == '_' || previous == '-' {
+		emit(TokenPunctuation, start, len(text))
+	} else {
+		emit(TokenWord, start, len(text))
+	}
+	return tokens
+}
@@ -0,0 +1,200 @@
+package buttifier
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	type token struct {
+		kind TokenKind
+		text string
+	}
+	resultMap := map[string][]token{
+		"hello, world!": {
+			{TokenWord, "hello"}, {TokenPunctuation, ","}, {TokenSpace, " "},
+			{TokenWord, "world"}, {TokenPunctuation, "!"},
+		},
+		"don't  stop\tthe well-known 'song'": {
+			{TokenWord, "don't"}, {TokenSpace, "  "}, {TokenWord, "stop"}, {TokenSpace, "\t"},
+			{TokenWord, "the"}, {TokenSpace, " "}, {TokenWord, "well-known"}, {TokenSpace, " "},
+			{TokenPunctuation, "'"}, {TokenWord, "song"}, {TokenPunctuation, "'"},
+		},
+		"wait...what?! 5+5 😂": {
+			{TokenWord, "wait"}, {TokenPunctuation, "..."}, {TokenWord, "what"}, {TokenPunctuation, "?!"},
+			{TokenSpace, " "}, {TokenWord, "5"}, {TokenSymbol, "+"}, {TokenWord, "5"},
+			{TokenSpace, " "}, {TokenSymbol, "😂"},
+		},
+	}
+	for text, expected := range resultMap {
+		tokens := Tokenize(text)
+		if len(tokens) != len(expected) {
+			t.Errorf("%q: expected %d tokens, got %d: %v", text, len(expected), len(tokens), tokens)
+			continue
+		}
+		for i, token := range tokens {
+			if token.Kind != expected[i].kind || token.Text != expected[i].text {
+				t.Errorf("%q: expected token %d to be %s %q, got %s %q", text, i, expected[i].kind, expected[i].text, token.Kind, token.Text)
+			}
+			if text[token.Start:token.End] != token.Text {
+				t.Errorf("%q: token %q has wrong offsets %d:%d", text, token.Text, token.Start, token.End)
+			}
+		}
+	}
+}
+
+func TestTokenizeRoundTrip(t *testing.T) {
+	texts := []string{
+		"",
+		"  leading and trailing  ",
+		"line one\nline two\r\n",
+		"invalid \xff utf-8",
+		"it's a—dash, ok?",
+	}
+	for _, text := range texts {
+		var builder strings.Builder
+		for _, token := range Tokenize(text) {
+			builder.WriteString(token.Text)
+		}
+		if builder.String() != text {
+			t.Errorf("expected %q, got %q", text, builder.String())
+		}
+	}
+}
+
+func TestButtifySentenceKeepsPunctuation(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	resultMap := map[string]string{
+		"grinding, for  partner!":  "buttbutt, for  partner!",
+		"grinding\tfor\npartner?!": "buttbutt\tfor\npartner?!",
+	}
+	for sentence, expected := range resultMap {
+		actual := b.ButtifySentence(sentence)
+		if expected != actual {
+			t.Errorf("expected %q, got %q", expected, actual)
+		}
+	}
+}
+
+func TestTokenizeProtected(t *testing.T) {
+	resultMap := map[string][]TokenKind{
+		"@someone hi":                             {TokenMention, TokenSpace, TokenWord},
+		"see https://example.com/a?b=c.":          {TokenWord, TokenSpace, TokenURL, TokenPunctuation},
+		"(www.example.com)":                       {TokenPunctuation, TokenURL, TokenPunctuation},
+		"https://en.wikipedia.org/wiki/Go_(game)": {TokenURL},
+		"mail someone@example.com":                {TokenWord, TokenSpace, TokenEmail},
+		"!uptime now":                             {TokenCommand, TokenSpace, TokenWord},
+		"wow!uptime":                              {TokenWord, TokenPunctuation, TokenWord},
+		"#MakeItButt, C#":                         {TokenHashtag, TokenPunctuation, TokenSpace, TokenWord, TokenPunctuation},
+	}
+	for text, expected := range resultMap {
+		tokens := Tokenize(text)
+		if len(tokens) != len(expected) {
+			t.Errorf("%q: expected %d tokens, got %d: %v", text, len(expected), len(tokens), tokens)
+			continue
+		}
+		for i, token := range tokens {
+			if token.Kind != expected[i] {
+				t.Errorf("%q: expected token %d to be %s, got %s %q", text, i, expected[i], token.Kind, token.Text)
+			}
+		}
+	}
+}
+
+func TestButtifyProtected(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	b.Emotes = NewEmoteSet("KEKW", "PogChamp", "D:")
+
+	resultMap := map[string]string{
+		"@someone grinding":                     "@someone buttbutt",
+		"grinding https://example.com/grinding": "buttbutt https://example.com/grinding",
+		"someone@example.com grinding":          "someone@example.com buttbutt",
+		"!grinding #grinding":                   "!grinding #grinding",
+		"KEKW grinding PogChamp D:":             "KEKW buttbutt PogChamp D:",
+		"kekw":                                  "butt",
+		"PogChamp, grinding":                    "ButT, buttbutt",
+	}
+	for text, expected := range resultMap {
+		actual := b.ButtifySentence(text)
+		if expected != actual {
+			t.Errorf("expected %s => %s, got %s => %s", text, expected, text, actual)
+		}
+	}
+
+	b.Unprotect = TokenHashtag | TokenEmote
+	if actual := b.ButtifySentence("#grinding KEKW"); actual != "#buttbutt BUTT" {
+		t.Errorf("expected #buttbutt BUTT, got %s", actual)
+	}
+}
+
+func TestSplitIdentifier(t *testing.T) {
+	resultMap := map[string][]string{
+		"getUserName": {"get", "User", "Name"},
+		"MakeItButt":  {"Make", "It", "Butt"},
+		"snake_case":  {"snake", "_", "case"},
+		"__init__":    {"__", "init", "__"},
+		"well-known":  {"well", "-", "known"},
+		"HTTPServer":  {"HTTP", "Server"},
+		"level2boss":  {"level", "2", "boss"},
+		"don't":       {"don't"},
+		"ÉcoleÉté":    {"École", "Été"},
+		"something":   {"something"},
+	}
+	for text, expected := range resultMap {
+		tokens := splitIdentifier(Token{Kind: TokenWord, Text: text, Start: 10, End: 10 + len(text)})
+		var actual []string
+		for _, token := range tokens {
+			actual = append(actual, token.Text)
+			if text[token.Start-10:token.End-10] != token.Text {
+				t.Errorf("%q: token %q has wrong offsets %d:%d", text, token.Text, token.Start, token.End)
+			}
+			if (token.Kind == TokenPunctuation) != strings.ContainsAny(token.Text, "_-") {
+				t.Errorf("%q: token %q has kind %s", text, token.Text, token.Kind)
+			}
+		}
+		if strings.Join(actual, "|") != strings.Join(expected, "|") {
+			t.Errorf("%q: expected %v, got %v", text, expected, actual)
+		}
+	}
+}
+
+func TestButtifySplitIdentifiers(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtificationRate = 1
+	b.SplitIdentifiers = true
+
+	resultMap := map[string]string{
+		"getUserName":        "buttButtButt",
+		"grinding_something": "buttbutt_buttbutt",
+		"well-known":         "butt-butt",
+		"#MakeItButt":        "#MakeItButt",
+		"SOMEONE_grinding":   "BUTTBUTT_buttbutt",
+	}
+	for text, expected := range resultMap {
+		actual := b.ButtifySentence(text)
+		if expected != actual {
+			t.Errorf("expected %s => %s, got %s => %s", text, expected, text, actual)
+		}
+	}
+
+	b.Unprotect = TokenHashtag
+	if actual := b.ButtifySentence("#MakeItButt"); actual != "#ButtButtButt" {
+		t.Errorf("expected #ButtButtButt, got %s", actual)
+	}
+	if result := b.Buttify("getUserName"); len(result.Edits) != 3 || result.Edits[2].WordIndex != 2 {
+		t.Errorf("expected one edit per part, got %+v", result.Edits)
+	}
+}
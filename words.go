@@ -0,0 +1,113 @@
+package buttifier
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidWeightedWords = errors.New("buttifier: invalid weighted word list")
+
+// a replacement word and how often it's picked relative to the others
+type WeightedWord struct {
+	Word   string
+	Weight float64
+}
+
+// when a word from Config.ButtWords is picked
+type WordChoice int
+
+const (
+	// every replaced syllable gets its own random word
+	ChoosePerSyllable WordChoice = iota
+	// one random word is used for the whole message
+	ChoosePerMessage
+)
+
+// parses "butt:10,booty:2,bum", words without a weight get 1
+func ParseWeightedWords(list string) ([]WeightedWord, error) {
+	var words []WeightedWord
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		word, weightText, hasWeight := strings.Cut(entry, ":")
+		weight := 1.0
+		if hasWeight {
+			var err error
+			weight, err = strconv.ParseFloat(strings.TrimSpace(weightText), 64)
+			if err != nil || weight < 0 || math.IsInf(weight, 0) || math.IsNaN(weight) {
+				return nil, fmt.Errorf("%w: bad weight in %q", ErrInvalidWeightedWords, entry)
+			}
+		}
+		word = strings.TrimSpace(word)
+		if word == "" {
+			return nil, fmt.Errorf("%w: empty word in %q", ErrInvalidWeightedWords, entry)
+		}
+		words = append(words, WeightedWord{Word: word, Weight: weight})
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("%w: no words in %q", ErrInvalidWeightedWords, list)
+	}
+	return words, nil
+}
+
+// the word that replaces syllable, before its case is normalized
+func (s *session) replacementWord(syllable string) string {
+	if len(s.ButtWords) == 0 {
+		return s.ButtWord
+	}
+
+	if s.WordChoice == ChoosePerMessage {
+		if s.messageWord == "" {
+			s.messageWord = s.pickWord(s.ButtWords, nil)
+		}
+		return s.messageWord
+	}
+
+	if !s.PreferSimilarLength {
+		return s.pickWord(s.ButtWords, nil)
+	}
+	// words that differ in length from the syllable get proportionally less likely
+	syllableLength := utf8.RuneCountInString(syllable)
+	return s.pickWord(s.ButtWords, func(word WeightedWord) float64 {
+		difference := utf8.RuneCountInString(word.Word) - syllableLength
+		return word.Weight / float64(1+max(difference, -difference))
+	})
+}
+
+// picks a word at random by weight, or by the weights returned by weightOf when it isn't nil
+func (s *session) pickWord(words []WeightedWord, weightOf func(WeightedWord) float64) string {
+	if weightOf == nil {
+		weightOf = func(word WeightedWord) float64 { return word.Weight }
+	}
+
+	total := 0.0
+	for _, word := range words {
+		total += max(weightOf(word), 0)
+	}
+	if total == 0 {
+		return s.ButtWord
+	}
+
+	rn := s.rng.Float64() * total
+	for _, word := range words {
+		weight := max(weightOf(word), 0)
+		if rn < weight {
+			return word.Word
+		}
+		rn -= weight
+	}
+	// floating point rounding can leave rn just above the last weight
+	for i := len(words) - 1; i >= 0; i-- {
+		if weightOf(words[i]) > 0 {
+			return words[i].Word
+		}
+	}
+	return s.ButtWord
+}
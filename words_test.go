@@ -0,0 +1,98 @@
+package buttifier
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestParseWeightedWords(t *testing.T) {
+	words, err := ParseWeightedWords("butt:10, booty:2.5,bum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []WeightedWord{{"butt", 10}, {"booty", 2.5}, {"bum", 1}}
+	if !slices.Equal(words, expected) {
+		t.Errorf("expected %v, got %v", expected, words)
+	}
+
+	for _, list := range []string{"", "butt:x", "butt:-1", ":3", " , "} {
+		if _, err := ParseWeightedWords(list); !errors.Is(err, ErrInvalidWeightedWords) {
+			t.Errorf("expected ErrInvalidWeightedWords for %q, got %v", list, err)
+		}
+	}
+}
+
+func TestButtWordsAreWeighted(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.RandSource = rand.NewPCG(3, 4)
+	b.ButtWords = []WeightedWord{{"butt", 10}, {"booty", 2}, {"bum", 0}}
+
+	counts := map[string]int{}
+	for range 1000 {
+		s := b.newSession("")
+		counts[s.replacementWord("some")]++
+	}
+	if counts["bum"] != 0 {
+		t.Errorf("expected words with weight 0 to never be picked, got %d", counts["bum"])
+	}
+	if counts["butt"] < 3*counts["booty"] || counts["booty"] == 0 {
+		t.Errorf("expected butt about 5 times more often than booty, got %v", counts)
+	}
+}
+
+func TestButtWordsKeepCase(t *testing.T) {
+	b, err := New()
+	b.RandSource = UnitTestRandSource{}
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.ButtWords = []WeightedWord{{"booty", 1}, {"bum", 1}}
+
+	if actual := b.ButtifySentence("GRINDING for Partner"); actual != "BOOTYBOOTY for Partner" {
+		t.Errorf("expected BOOTYBOOTY for Partner, got %s", actual)
+	}
+}
+
+func TestButtWordsPerMessage(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.RandSource = rand.NewPCG(5, 6)
+	b.ButtificationRate = 1
+	b.ButtWords = []WeightedWord{{"butt", 1}, {"booty", 1}, {"bum", 1}}
+	b.WordChoice = ChoosePerMessage
+
+	for range 20 {
+		result := b.Buttify("someone developers computer")
+		for _, edit := range result.Edits {
+			if edit.Replacement != result.Edits[0].Replacement {
+				t.Fatalf("expected a single word per message, got %s", result.Output)
+			}
+		}
+	}
+}
+
+func TestButtWordsPreferSimilarLength(t *testing.T) {
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.RandSource = rand.NewPCG(7, 8)
+	b.ButtWords = []WeightedWord{{"bo", 1}, {"butt", 1}, {"bootybooty", 1}}
+	b.PreferSimilarLength = true
+
+	counts := map[string]int{}
+	for range 1000 {
+		s := b.newSession("")
+		counts[s.replacementWord("grind")]++
+	}
+	if counts["butt"] <= counts["bo"] || counts["bo"] <= counts["bootybooty"] {
+		t.Errorf("expected words closer to 5 letters to be picked more often, got %v", counts)
+	}
+}